@@ -16,15 +16,16 @@ func main() {
 	migrations := flag.String("migrations", "", "path of the generated state migration file, defaults to <dir>/v2-migrate.tf")
 	reportPath := flag.String("report", "", "write the report to this file in addition to stdout")
 	dryRun := flag.Bool("dry-run", false, "print the report without writing any files")
+	useImport := flag.Bool("import", false, "write removed and import blocks instead of moved blocks, for terraform before 1.8")
 	flag.Parse()
 
-	if err := run(*dir, *state, *migrations, *reportPath, *dryRun); err != nil {
+	if err := run(*dir, *state, *migrations, *reportPath, *dryRun, *useImport); err != nil {
 		fmt.Fprintln(os.Stderr, "error:", err)
 		os.Exit(1)
 	}
 }
 
-func run(dir, state, migrations, reportPath string, dryRun bool) error {
+func run(dir, state, migrations, reportPath string, dryRun, useImport bool) error {
 	if state == "" {
 		def := filepath.Join(dir, "terraform.tfstate")
 		if _, err := os.Stat(def); err == nil {
@@ -42,6 +43,7 @@ func run(dir, state, migrations, reportPath string, dryRun bool) error {
 		Dir:            dir,
 		StatePath:      state,
 		MigrationsPath: migrations,
+		Import:         useImport,
 	})
 	if err != nil {
 		return fmt.Errorf("convert: %w", err)
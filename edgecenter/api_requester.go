@@ -0,0 +1,128 @@
+package edgecenter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	cdnsdk "github.com/Edge-Center/edgecentercdn-go/edgecenter"
+)
+
+const apiRequestTimeout = time.Minute
+
+// apiRequester implements the Requester interface of the CDN and RMON SDKs over the
+// provider's shared HTTP client. The provider clients of those SDKs always build their
+// own http.Client, so requests sent through them would bypass retries and rate limiting.
+type apiRequester struct {
+	client   *http.Client
+	baseURL  string
+	ua       string
+	sign     func(req *http.Request) error
+	apiError func(statusCode int, body []byte) error
+}
+
+func newAPIRequester(
+	httpClient *http.Client,
+	baseURL, userAgent string,
+	sign func(req *http.Request) error,
+	apiError func(statusCode int, body []byte) error,
+) *apiRequester {
+	return &apiRequester{
+		client:   &http.Client{Transport: httpClient.Transport, Timeout: apiRequestTimeout},
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		ua:       userAgent,
+		sign:     sign,
+		apiError: apiError,
+	}
+}
+
+func (r *apiRequester) Request(ctx context.Context, method, path string, payload interface{}, result interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		payloadBuf := new(bytes.Buffer)
+		if err := json.NewEncoder(payloadBuf).Encode(payload); err != nil {
+			return fmt.Errorf("encode req payload: %w", err)
+		}
+		body = payloadBuf
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, r.baseURL+"/"+strings.TrimPrefix(path, "/"), body)
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.ua != "" {
+		req.Header.Set("User-Agent", r.ua)
+	}
+	if r.sign != nil {
+		if err := r.sign(req); err != nil {
+			return err
+		}
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("read error response %d: %w", resp.StatusCode, err)
+		}
+
+		return fmt.Errorf("%s %s: %w", method, path, r.apiError(resp.StatusCode, respBody))
+	}
+
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("decode successful resp %d: %w", resp.StatusCode, err)
+		}
+	}
+
+	return nil
+}
+
+// cdnAPIError builds the same error as the CDN SDK, so errors.Is with the SDK sentinels keeps working.
+func cdnAPIError(statusCode int, body []byte) error {
+	var sentinel error
+	switch statusCode {
+	case http.StatusBadRequest:
+		sentinel = cdnsdk.ErrBadRequest
+	case http.StatusUnauthorized:
+		sentinel = cdnsdk.ErrUnauthorized
+	case http.StatusForbidden:
+		sentinel = cdnsdk.ErrForbidden
+	case http.StatusNotFound:
+		sentinel = cdnsdk.ErrNotFound
+	case http.StatusConflict:
+		sentinel = cdnsdk.ErrConflict
+	case http.StatusTooManyRequests:
+		sentinel = cdnsdk.ErrRateLimit
+	}
+
+	apiErr := cdnsdk.NewAPIError(statusCode, sentinel)
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, apiErr); err != nil {
+			apiErr.Message = strings.TrimSpace(string(body))
+		}
+	}
+
+	return apiErr
+}
+
+// statusAPIError is used for APIs whose SDK does not export a typed error.
+func statusAPIError(statusCode int, body []byte) error {
+	msg := strings.TrimSpace(string(body))
+	if msg == "" {
+		msg = http.StatusText(statusCode)
+	}
+
+	return fmt.Errorf("status %d: %s", statusCode, msg)
+}
@@ -0,0 +1,62 @@
+package edgecenter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cdnsdk "github.com/Edge-Center/edgecentercdn-go/edgecenter"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/shared/transport"
+)
+
+func TestAPIRequesterRetriesThroughSharedTransport(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("Authorization") != "APIKey token" {
+			t.Errorf("request is not signed")
+		}
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":7}`))
+	}))
+	defer srv.Close()
+
+	httpClient := &http.Client{Transport: transport.NewRetry(nil, transport.RetryOptions{MaxRetries: 2})}
+	sign := func(req *http.Request) error {
+		req.Header.Set("Authorization", "APIKey token")
+		return nil
+	}
+	r := newAPIRequester(httpClient, srv.URL+"/", "test-agent", sign, cdnAPIError)
+
+	var result struct {
+		ID int `json:"id"`
+	}
+	if err := r.Request(context.Background(), http.MethodGet, "/cdn/resources/7", nil, &result); err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	if calls != 2 || result.ID != 7 {
+		t.Errorf("calls = %d, id = %d, want 2 calls and id 7", calls, result.ID)
+	}
+}
+
+func TestCDNAPIErrorKeepsSDKSentinels(t *testing.T) {
+	err := cdnAPIError(http.StatusNotFound, []byte(`{"message":"resource not found"}`))
+	if !errors.Is(err, cdnsdk.ErrNotFound) {
+		t.Errorf("errors.Is(%v, ErrNotFound) = false", err)
+	}
+
+	err = cdnAPIError(http.StatusBadRequest, []byte("plain text"))
+	if !errors.Is(err, cdnsdk.ErrBadRequest) {
+		t.Errorf("errors.Is(%v, ErrBadRequest) = false", err)
+	}
+	if err.Error() != "plain text" {
+		t.Errorf("message = %q, want body text", err.Error())
+	}
+}
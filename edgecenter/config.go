@@ -3,6 +3,7 @@ package edgecenter
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	dnsSDK "github.com/Edge-Center/edgecenter-dns-sdk-go"
 	storageSDK "github.com/Edge-Center/edgecenter-storage-sdk-go"
@@ -15,6 +16,7 @@ import (
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 	rmon "github.com/Edge-Center/edgecenteredgemon-go"
 	protection "github.com/Edge-Center/edgecenterprotection-go"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/shared/transport"
 )
 
 type DNSZoneService interface {
@@ -84,6 +86,25 @@ type Config struct {
 	ProtectionClient   *protection.Client
 	RmonClient         rmon.ClientService
 	CloudClientFactory func() (*edgecloudV2.Client, error)
+
+	// HTTPClient is shared by the API clients of all products. Its transport retries
+	// throttled requests and applies the provider-wide rate limit.
+	HTTPClient *http.Client
+
+	// Default project and region for cloud resources that specify neither the ID nor the name.
+	DefaultProjectID   int
+	DefaultProjectName string
+	DefaultRegionID    int
+	DefaultRegionName  string
+
+	// DefaultMetadata is merged into the metadata of every metadata-capable cloud resource.
+	DefaultMetadata map[string]string
+
+	// QuotaPreflight makes the plan fail when the planned resources exceed the remaining quota.
+	QuotaPreflight bool
+
+	names  *nameCache
+	quotas *quotaLedger
 }
 
 func NewConfig(
@@ -107,6 +128,7 @@ func NewConfig(
 		DNSClient:        dnsClient,
 		ProtectionClient: protectionClient,
 		RmonClient:       rmonClient,
+		names:            newNameCache(),
 	}
 }
 
@@ -115,14 +137,32 @@ func (c *Config) NewCloudClient() (*edgecloudV2.Client, error) {
 		return c.CloudClientFactory()
 	}
 
-	cloudClient, err := edgecloudV2.NewWithRetries(nil,
+	opts := []edgecloudV2.ClientOpt{
 		edgecloudV2.SetUserAgent(c.UserAgent),
 		edgecloudV2.SetAPIKey(c.PermanentToken),
 		edgecloudV2.SetBaseURL(c.CloudBaseURL),
-	)
+	}
+
+	var cloudClient *edgecloudV2.Client
+	var err error
+	if c.HTTPClient != nil {
+		// Retries are done by the shared transport, retrying in the SDK as well would multiply them.
+		cloudClient, err = edgecloudV2.New(tracedHTTPClient(c.HTTPClient, transport.SubsystemCloud), opts...)
+	} else {
+		cloudClient, err = edgecloudV2.NewWithRetries(nil, opts...)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error from creating cloud client: %w", err)
 	}
 
 	return cloudClient, nil
 }
+
+// tracedHTTPClient returns a client that goes through the transport of base and logs
+// its requests under the given tflog subsystem.
+func tracedHTTPClient(base *http.Client, subsystem string) *http.Client {
+	return &http.Client{
+		Transport: transport.NewLogging(base.Transport, subsystem),
+		Timeout:   base.Timeout,
+	}
+}
@@ -15,16 +15,18 @@ func dataSourceAvailabilityZone() *schema.Resource {
 		Description: "Represent Availability Zones",
 		Schema: map[string]*schema.Schema{
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The ID of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"availability_zones": {
 				Type:        schema.TypeList,
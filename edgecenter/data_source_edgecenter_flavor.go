@@ -22,28 +22,32 @@ func dataSourceFlavor() *schema.Resource {
 		Description: "Represent flavors",
 		Schema: map[string]*schema.Schema{
 			ProjectIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			ProjectNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			RegionIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			RegionNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			IncludeDisabledField: {
 				Type:        schema.TypeBool,
@@ -24,16 +24,18 @@ allowing it to have a static public IP address. The floating IP can be re-associ
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"id": {
 				Type:         schema.TypeString,
@@ -43,16 +45,18 @@ allowing it to have a static public IP address. The floating IP can be re-associ
 				ExactlyOneOf: []string{"id", "floating_ip_address"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"floating_ip_address": {
 				Type:        schema.TypeString,
@@ -151,28 +155,7 @@ func dataSourceFloatingIPRead(ctx context.Context, d *schema.ResourceData, m int
 		return diag.Errorf("Error: specify either a floating_ip_address or id to lookup the floating ip")
 	}
 	d.SetId(foundFloatingIP.ID)
-
-	if foundFloatingIP.FixedIPAddress != nil {
-		d.Set("fixed_ip_address", foundFloatingIP.FixedIPAddress.String())
-	} else {
-		d.Set("fixed_ip_address", "")
-	}
-
-	d.Set("project_id", foundFloatingIP.ProjectID)
-	d.Set("region_id", foundFloatingIP.RegionID)
-	d.Set("status", foundFloatingIP.Status)
-	d.Set("port_id", foundFloatingIP.PortID)
-	if foundFloatingIP.Instance.ID != "" {
-		d.Set("instance_id_attached_to", foundFloatingIP.Instance.ID)
-	}
-	if foundFloatingIP.Loadbalancer.ID != "" {
-		d.Set("load_balancers_id_attached_to", foundFloatingIP.Loadbalancer.ID)
-	}
-	d.Set("router_id", foundFloatingIP.RouterID)
-	d.Set("floating_ip_address", foundFloatingIP.FloatingIPAddress)
-
-	metadataReadOnly := PrepareMetadataReadonly(foundFloatingIP.Metadata)
-	if err := d.Set("metadata_read_only", metadataReadOnly); err != nil {
+	if err := setDataSourceFields(d, flattenFloatingIPData(foundFloatingIP)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -180,3 +163,30 @@ func dataSourceFloatingIPRead(ctx context.Context, d *schema.ResourceData, m int
 
 	return diags
 }
+
+// flattenFloatingIPData returns the attributes of the floating IP, shared by edgecenter_floatingip
+// and edgecenter_floatingips.
+func flattenFloatingIPData(floatingIP *edgecloudV2.FloatingIP) map[string]interface{} {
+	fields := map[string]interface{}{
+		"id":                  floatingIP.ID,
+		"fixed_ip_address":    "",
+		"project_id":          floatingIP.ProjectID,
+		"region_id":           floatingIP.RegionID,
+		"status":              string(floatingIP.Status),
+		"port_id":             floatingIP.PortID,
+		"router_id":           floatingIP.RouterID,
+		"floating_ip_address": floatingIP.FloatingIPAddress,
+		"metadata_read_only":  PrepareMetadataReadonly(floatingIP.Metadata),
+	}
+	if floatingIP.FixedIPAddress != nil {
+		fields["fixed_ip_address"] = floatingIP.FixedIPAddress.String()
+	}
+	if floatingIP.Instance.ID != "" {
+		fields["instance_id_attached_to"] = floatingIP.Instance.ID
+	}
+	if floatingIP.Loadbalancer.ID != "" {
+		fields["load_balancers_id_attached_to"] = floatingIP.Loadbalancer.ID
+	}
+
+	return fields
+}
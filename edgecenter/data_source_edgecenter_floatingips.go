@@ -0,0 +1,59 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFloatingIPs() *schema.Resource {
+	item := computedElem(dataSourceFloatingIP().Schema, ProjectNameField, RegionNameField)
+	item.Schema[IDField].Description = "The ID (uuid) of the floating IP."
+
+	s := listDataSourceSchema(FloatingIPsField, "The list of the floating IPs, shaped like the 'edgecenter_floatingip' data source.", item, true)
+	s[NameRegexField].Description = "A regular expression the floating IP address must match, e.g. '^185\\.'. Floating IPs have no name."
+
+	return &schema.Resource{
+		ReadContext: dataSourceFloatingIPsRead,
+		Description: "Represent the floating IPs of a project in a region, filtered by address, status and metadata.",
+		Schema:      s,
+	}
+}
+
+func dataSourceFloatingIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start FloatingIPs reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	floatingIPs, _, err := clientV2.Floatingips.List(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := make([]map[string]interface{}, 0, len(floatingIPs))
+	for i := range floatingIPs {
+		floatingIP := &floatingIPs[i]
+		if !filter.match(floatingIP.FloatingIPAddress, string(floatingIP.Status), metadataDetailedToMap(floatingIP.Metadata)) {
+			continue
+		}
+		items = append(items, flattenFloatingIPData(floatingIP))
+	}
+
+	if err := setListDataSource(d, clientV2, FloatingIPsField, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finish FloatingIPs reading, found %d", len(items))
+
+	return nil
+}
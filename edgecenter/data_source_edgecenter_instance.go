@@ -19,28 +19,32 @@ func dataSourceInstance() *schema.Resource {
 		DeprecationMessage: "!> **WARNING:** This data-source is deprecated and will be removed in the next major version. Use \"edgecenter_instanceV2\" data-source instead",
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:        schema.TypeString,
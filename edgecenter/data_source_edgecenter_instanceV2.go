@@ -43,28 +43,32 @@ func dataSourceInstanceV2() *schema.Resource {
 		Description: `A cloud instance is a virtual machine in a cloud environment. Could be used with baremetal too.`,
 		Schema: map[string]*schema.Schema{
 			ProjectIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectNameField},
 			},
 			ProjectNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectIDField},
 			},
 			RegionIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionNameField},
 			},
 			RegionNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionIDField},
 			},
 			NameField: {
 				Type:         schema.TypeString,
@@ -182,20 +186,29 @@ func dataSourceInstanceV2Read(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
+	fields, err := flattenInstanceV2Data(ctx, clientV2, instance)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(instance.ID)
-	_ = d.Set(NameField, instance.Name)
-	_ = d.Set(IDField, instance.ID)
-	_ = d.Set(FlavorIDField, instance.Flavor.FlavorID)
-	_ = d.Set(StatusField, instance.Status)
-	_ = d.Set(InstanceVMStateField, instance.VMState)
-	_ = d.Set(AvailabilityZoneField, instance.AvailabilityZone)
+	if err := setDataSourceFields(d, fields); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Println("[DEBUG] Finish Instance reading")
+
+	return nil
+}
 
+// flattenInstanceV2Data returns the attributes of the instance, shared by edgecenter_instanceV2 and edgecenter_instances.
+// It requests the volumes and the interfaces of the instance.
+func flattenInstanceV2Data(ctx context.Context, clientV2 *edgecloudV2.Client, instance *edgecloudV2.Instance) (map[string]interface{}, error) {
 	flavor := make(map[string]interface{}, 4)
 	flavor[FlavorIDField] = instance.Flavor.FlavorID
 	flavor[FlavorNameField] = instance.Flavor.FlavorName
 	flavor[RAMField] = strconv.Itoa(instance.Flavor.RAM)
 	flavor[VCPUsField] = strconv.Itoa(instance.Flavor.VCPUS)
-	_ = d.Set(FlavorField, flavor)
 
 	volumesReq := edgecloudV2.VolumeListOptions{
 		InstanceID: instance.ID,
@@ -203,21 +216,14 @@ func dataSourceInstanceV2Read(ctx context.Context, d *schema.ResourceData, m int
 
 	instanceVolumes, _, err := clientV2.Volumes.List(ctx, &volumesReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
 	bootVolumesData, dataVolumesData := PrepareVolumesDataToSet(instanceVolumes)
-	if err := d.Set(InstanceBootVolumesField, bootVolumesData); err != nil {
-		return diag.FromErr(err)
-	}
-
-	if err := d.Set(InstanceDataVolumesField, dataVolumesData); err != nil {
-		return diag.FromErr(err)
-	}
 
 	ifs, _, err := clientV2.Instances.InterfaceList(ctx, instance.ID)
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
 	log.Printf("instance data source interfaces: %+v", ifs)
@@ -244,19 +250,27 @@ func dataSourceInstanceV2Read(ctx context.Context, d *schema.ResourceData, m int
 		}
 	}
 
-	if err := d.Set(InstanceInterfacesField, cleanInterfaces); err != nil {
-		return diag.FromErr(err)
-	}
+	return map[string]interface{}{
+		NameField:                instance.Name,
+		IDField:                  instance.ID,
+		FlavorIDField:            instance.Flavor.FlavorID,
+		StatusField:              instance.Status,
+		InstanceVMStateField:     instance.VMState,
+		AvailabilityZoneField:    instance.AvailabilityZone,
+		FlavorField:              flavor,
+		InstanceBootVolumesField: bootVolumesData,
+		InstanceDataVolumesField: dataVolumesData,
+		InstanceInterfacesField:  cleanInterfaces,
+		MetadataField:            instanceMetadataMap(instance),
+	}, nil
+}
 
-	metadata := make(map[string]interface{}, len(instance.Metadata))
+// instanceMetadataMap returns the metadata of the instance as strings.
+func instanceMetadataMap(instance *edgecloudV2.Instance) map[string]string {
+	metadata := make(map[string]string, len(instance.Metadata))
 	for key, value := range instance.Metadata {
-		metadata[key] = value
-	}
-	if err = d.Set(MetadataField, metadata); err != nil {
-		return diag.FromErr(err)
+		metadata[key] = fmt.Sprint(value)
 	}
 
-	log.Println("[DEBUG] Finish Instance reading")
-
-	return nil
+	return metadata
 }
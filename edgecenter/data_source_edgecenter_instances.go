@@ -0,0 +1,66 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func dataSourceInstances() *schema.Resource {
+	item := computedElem(dataSourceInstanceV2().Schema, ProjectIDField, ProjectNameField, RegionIDField, RegionNameField)
+	item.Schema[NameField].Description = "The name of the instance."
+	item.Schema[IDField].Description = "The ID of the instance."
+
+	return &schema.Resource{
+		ReadContext: dataSourceInstancesRead,
+		Description: "Represent the instances of a project in a region, filtered by name, status and metadata.",
+		Schema:      listDataSourceSchema(InstancesField, "The list of the instances, shaped like the 'edgecenter_instanceV2' data source.", item, true),
+	}
+}
+
+func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Instances reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instances, err := listAllPages(func(limit, offset int) ([]edgecloudV2.Instance, error) {
+		page, _, err := clientV2.Instances.List(ctx, &edgecloudV2.InstanceListOptions{Limit: limit, Offset: offset})
+		return page, err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := make([]map[string]interface{}, 0, len(instances))
+	for i := range instances {
+		instance := &instances[i]
+		if !filter.match(instance.Name, string(instance.Status), instanceMetadataMap(instance)) {
+			continue
+		}
+		fields, err := flattenInstanceV2Data(ctx, clientV2, instance)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		items = append(items, fields)
+	}
+
+	if err := setListDataSource(d, clientV2, InstancesField, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finish Instances reading, found %d", len(items))
+
+	return nil
+}
@@ -17,28 +17,32 @@ func dataSourceLBPool() *schema.Resource {
 		Description: "Represent information about load balancer listener pool. A pool is a list of virtual machines to which the listener will redirect incoming traffic.",
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -190,21 +194,7 @@ func dataSourceLBPoolRead(ctx context.Context, d *schema.ResourceData, m interfa
 		_ = d.Set("listener_id", pool.Listeners[0].ID)
 	}
 
-	if pool.HealthMonitor != nil {
-		healthMonitor := map[string]interface{}{
-			"id":               pool.HealthMonitor.ID,
-			"type":             pool.HealthMonitor.Type,
-			"delay":            pool.HealthMonitor.Delay,
-			"timeout":          pool.HealthMonitor.Timeout,
-			"max_retries":      pool.HealthMonitor.MaxRetries,
-			"max_retries_down": pool.HealthMonitor.MaxRetriesDown,
-			"url_path":         pool.HealthMonitor.URLPath,
-			"expected_codes":   pool.HealthMonitor.ExpectedCodes,
-		}
-		if pool.HealthMonitor.HTTPMethod != nil {
-			healthMonitor["http_method"] = pool.HealthMonitor.HTTPMethod
-		}
-
+	if healthMonitor := flattenHealthMonitorV2(pool); healthMonitor != nil {
 		if err := d.Set("health_monitor", []interface{}{healthMonitor}); err != nil {
 			return diag.FromErr(err)
 		}
@@ -0,0 +1,73 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func dataSourceLoadBalancers() *schema.Resource {
+	item := computedElem(dataSourceLoadBalancerV2().Schema, ProjectNameField, RegionNameField, MetadataKField, MetadataKVField)
+	item.Schema[NameField].Description = "The name of the load balancer."
+	item.Schema[IDField].Description = "The ID of the load balancer."
+
+	s := listDataSourceSchema(LoadBalancersField, "The list of the load balancers, shaped like the 'edgecenter_loadbalancerv2' data source.", item, true)
+	s[StatusField].Description = "Only return the load balancers with this provisioning status, e.g. 'ACTIVE'."
+
+	return &schema.Resource{
+		ReadContext: dataSourceLoadBalancersRead,
+		Description: "Represent the load balancers of a project in a region, filtered by name, provisioning status and metadata.",
+		Schema:      s,
+	}
+}
+
+func dataSourceLoadBalancersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LoadBalancers reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	metadataKV, err := filter.metadataKVQuery()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lbs, _, err := clientV2.Loadbalancers.List(ctx, &edgecloudV2.LoadbalancerListOptions{MetadataK: filter.metadataK, MetadataKV: metadataKV})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := make([]map[string]interface{}, 0, len(lbs))
+	for i := range lbs {
+		lb := &lbs[i]
+		if !filter.matchName(lb.Name) || !filter.matchStatus(string(lb.ProvisioningStatus)) {
+			continue
+		}
+		metadata, metadataReadOnly, err := loadBalancerMetadata(ctx, clientV2, lb.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !filter.matchMetadata(metadata) {
+			continue
+		}
+		items = append(items, flattenLoadBalancerV2Data(lb, metadataReadOnly))
+	}
+
+	if err := setListDataSource(d, clientV2, LoadBalancersField, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finish LoadBalancers reading, found %d", len(items))
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 )
 
 func dataSourceLoadBalancerV2() *schema.Resource {
@@ -13,28 +15,32 @@ func dataSourceLoadBalancerV2() *schema.Resource {
 		ReadContext: dataSourceLoadBalancerV2Read,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -73,6 +79,16 @@ func dataSourceLoadBalancerV2() *schema.Resource {
 				Computed:    true,
 				Description: "Attached reserved IP.",
 			},
+			ProvisioningStatusField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The provisioning status of the load balancer, e.g. 'ACTIVE' or 'ERROR'.",
+			},
+			OperatingStatusField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating status of the load balancer, e.g. 'ONLINE' or 'OFFLINE'.",
+			},
 			"metadata_read_only": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -111,31 +127,13 @@ func dataSourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	d.SetId(lb.ID)
-	_ = d.Set("project_id", lb.ProjectID)
-	_ = d.Set("region_id", lb.RegionID)
-	_ = d.Set("name", lb.Name)
-	_ = d.Set("id", lb.ID)
-	_ = d.Set("vip_address", lb.VipAddress.String())
-	_ = d.Set("vip_port_id", lb.VipPortID)
-
-	metadataList, _, err := clientV2.Loadbalancers.MetadataList(ctx, lb.ID)
+	_, metadataReadOnly, err := loadBalancerMetadata(ctx, clientV2, lb.ID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	metadataReadOnly := make([]map[string]interface{}, 0, len(metadataList))
-	if len(metadataList) > 0 {
-		for _, metadataItem := range metadataList {
-			metadataReadOnly = append(metadataReadOnly, map[string]interface{}{
-				"key":       metadataItem.Key,
-				"value":     metadataItem.Value,
-				"read_only": metadataItem.ReadOnly,
-			})
-		}
-	}
-
-	if err := d.Set("metadata_read_only", metadataReadOnly); err != nil {
+	d.SetId(lb.ID)
+	if err := setDataSourceFields(d, flattenLoadBalancerV2Data(lb, metadataReadOnly)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -143,3 +141,40 @@ func dataSourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+// loadBalancerMetadata returns the metadata of the load balancer as a map and as the read-only metadata items.
+func loadBalancerMetadata(ctx context.Context, clientV2 *edgecloudV2.Client, lbID string) (map[string]string, []map[string]interface{}, error) {
+	metadataList, _, err := clientV2.Loadbalancers.MetadataList(ctx, lbID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := make(map[string]string, len(metadataList))
+	metadataReadOnly := make([]map[string]interface{}, 0, len(metadataList))
+	for _, metadataItem := range metadataList {
+		metadata[metadataItem.Key] = metadataItem.Value
+		metadataReadOnly = append(metadataReadOnly, map[string]interface{}{
+			"key":       metadataItem.Key,
+			"value":     metadataItem.Value,
+			"read_only": metadataItem.ReadOnly,
+		})
+	}
+
+	return metadata, metadataReadOnly, nil
+}
+
+// flattenLoadBalancerV2Data returns the attributes of the load balancer, shared by edgecenter_loadbalancerv2
+// and edgecenter_loadbalancers.
+func flattenLoadBalancerV2Data(lb *edgecloudV2.Loadbalancer, metadataReadOnly []map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"project_id":            lb.ProjectID,
+		"region_id":             lb.RegionID,
+		"name":                  lb.Name,
+		"id":                    lb.ID,
+		"vip_address":           lb.VipAddress.String(),
+		"vip_port_id":           lb.VipPortID,
+		ProvisioningStatusField: string(lb.ProvisioningStatus),
+		OperatingStatusField:    string(lb.OperatingStatus),
+		"metadata_read_only":    metadataReadOnly,
+	}
+}
@@ -17,28 +17,32 @@ func dataSourceNetwork() *schema.Resource {
 		Description: "Represent network. A network is a software-defined network in a cloud computing infrastructure",
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -268,14 +272,9 @@ func dataSourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	d.SetId(rawNetwork["id"].(string))
-	_ = d.Set("name", rawNetwork["name"])
-	_ = d.Set("id", rawNetwork["id"])
-	_ = d.Set("mtu", rawNetwork["mtu"])
-	_ = d.Set("type", rawNetwork["type"])
-	_ = d.Set("region_id", rawNetwork["region_id"])
-	_ = d.Set("project_id", rawNetwork["project_id"])
-	_ = d.Set("external", rawNetwork["external"])
-	_ = d.Set("shared", rawNetwork["shared"])
+	if err := setDataSourceFields(d, flattenNetworkData(rawNetwork, meta)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	if withDetails && len(subs) > 0 {
 		if err := d.Set("subnets", PrepareSubnets(subs)); err != nil {
@@ -283,12 +282,23 @@ func dataSourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 
-	metadataReadOnly := PrepareMetadataReadonly(meta)
-	if err := d.Set("metadata_read_only", metadataReadOnly); err != nil {
-		return diag.FromErr(err)
-	}
-
 	log.Println("[DEBUG] Finish Network reading")
 
 	return nil
 }
+
+// flattenNetworkData returns the attributes of the network, shared by edgecenter_network and edgecenter_networks.
+// rawNetwork is the network converted by StructToMap.
+func flattenNetworkData(rawNetwork map[string]interface{}, meta []edgecloudV2.MetadataDetailed) map[string]interface{} {
+	return map[string]interface{}{
+		"name":               rawNetwork["name"],
+		"id":                 rawNetwork["id"],
+		"mtu":                rawNetwork["mtu"],
+		"type":               rawNetwork["type"],
+		"region_id":          rawNetwork["region_id"],
+		"project_id":         rawNetwork["project_id"],
+		"external":           rawNetwork["external"],
+		"shared":             rawNetwork["shared"],
+		"metadata_read_only": PrepareMetadataReadonly(meta),
+	}
+}
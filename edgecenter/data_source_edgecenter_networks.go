@@ -0,0 +1,67 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func dataSourceNetworks() *schema.Resource {
+	item := computedElem(dataSourceNetwork().Schema,
+		ProjectNameField, RegionNameField, MetadataKField, MetadataKVField, "shared_with_subnets", SubnetsField)
+	item.Schema[NameField].Description = "The name of the network."
+	item.Schema[IDField].Description = "The ID of the network."
+
+	return &schema.Resource{
+		ReadContext: dataSourceNetworksRead,
+		Description: "Represent the networks of a project in a region, filtered by name and metadata.",
+		Schema:      listDataSourceSchema(NetworksField, "The list of the networks, shaped like the 'edgecenter_network' data source.", item, false),
+	}
+}
+
+func dataSourceNetworksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Networks reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	metadataKV, err := filter.metadataKVQuery()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nets, _, err := clientV2.Networks.List(ctx, &edgecloudV2.NetworkListOptions{MetadataK: filter.metadataK, MetadataKV: metadataKV})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := make([]map[string]interface{}, 0, len(nets))
+	for _, network := range nets {
+		if !filter.match(network.Name, "", metadataDetailedToMap(network.Metadata)) {
+			continue
+		}
+		rawNetwork, err := StructToMap(network)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		items = append(items, flattenNetworkData(rawNetwork, network.Metadata))
+	}
+
+	if err := setListDataSource(d, clientV2, NetworksField, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finish Networks reading, found %d", len(items))
+
+	return nil
+}
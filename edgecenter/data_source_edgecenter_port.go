@@ -0,0 +1,182 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func dataSourcePort() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePortRead,
+		Description: "Represent a network port, found by its ID or by its fixed IP address.",
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
+			},
+			"project_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
+			},
+			"region_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
+			},
+			"port_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The uuid of the port. Either 'port_id' or 'fixed_ip_address' must be specified.",
+				ExactlyOneOf: []string{"port_id", "fixed_ip_address"},
+			},
+			"fixed_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The fixed IP address of the port. Either 'port_id' or 'fixed_ip_address' must be specified.",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"network_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uuid of the network of the port.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uuid of the subnet of the port.",
+			},
+			"allowed_address_pairs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IP addresses that are allowed to send traffic through the port besides its own.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			PortSecurityDisabledField: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the port security is disabled. Only known when the port is attached to an instance.",
+			},
+			"security_group_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The uuids of the security groups of the port. Only known when the port is attached to an instance.",
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uuid of the instance the port is attached to.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the port.",
+			},
+		},
+	}
+}
+
+func dataSourcePortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Port reading")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var port *edgecloudV2.ReservedFixedIP
+	if portID := d.Get("port_id").(string); portID != "" {
+		var resp *edgecloudV2.Response
+		port, resp, err = clientV2.ReservedFixedIP.Get(ctx, portID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return diag.Errorf("port %s not found", portID)
+			}
+
+			return diag.FromErr(err)
+		}
+	} else {
+		ipAddr := d.Get("fixed_ip_address").(string)
+		ports, _, err := clientV2.ReservedFixedIP.List(ctx, &edgecloudV2.ReservedFixedIPListOptions{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for i := range ports {
+			if ports[i].FixedIPAddress.String() == ipAddr {
+				port = &ports[i]
+				break
+			}
+		}
+
+		if port == nil {
+			return diag.Errorf("port with fixed ip address %s not found", ipAddr)
+		}
+	}
+
+	d.SetId(port.PortID)
+	d.Set("project_id", port.ProjectID)
+	d.Set("region_id", port.RegionID)
+	d.Set("port_id", port.PortID)
+	d.Set("fixed_ip_address", port.FixedIPAddress.String())
+	d.Set("network_id", port.NetworkID)
+	d.Set("subnet_id", port.SubnetID)
+	d.Set("status", port.Status)
+	if err := d.Set("allowed_address_pairs", flattenAllowedAddressPairs(port)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := portInstanceID(port)
+	d.Set("instance_id", instanceID)
+	if instanceID != "" {
+		portSecurityEnabled, sgIDs, err := portInstanceSecurity(ctx, clientV2, instanceID, port.PortID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set(PortSecurityDisabledField, !portSecurityEnabled)
+		if err := d.Set("security_group_ids", schema.NewSet(schema.HashString, sgIDs)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Println("[DEBUG] Finish Port reading")
+
+	return diags
+}
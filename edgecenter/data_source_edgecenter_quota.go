@@ -0,0 +1,84 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceQuota() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceQuotaRead,
+		Description: `Represent the quota limits and usage of the account in a region.
+The quotas are shared by all projects of the account: the regional quotas are combined with the global ones, e.g. 'project_count' and 'keypair_count'.`,
+		Schema: map[string]*schema.Schema{
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
+			},
+			"region_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
+			},
+			"limits": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The limit of every quota, keyed by the quota name, e.g. 'cpu_count', 'ram' or 'volume_size'. A negative limit means unlimited.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"usage": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The usage of every quota, keyed by the quota name.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"remaining": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "How much of every limited quota is left, keyed by the quota name.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceQuotaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Quota reading")
+	config := m.(*Config)
+
+	clientV2, err := InitCloudClient(ctx, d, m, &CloudClientConf{DoNotUseProjectID: true})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	q, err := fetchRegionQuota(config, clientV2.Region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remaining := make(map[string]int, len(q.limits))
+	for name := range q.limits {
+		if v, limited := q.remaining(name); limited {
+			remaining[name] = v
+		}
+	}
+
+	d.SetId(strconv.Itoa(clientV2.Region))
+	d.Set("region_id", clientV2.Region)
+	d.Set("limits", q.limits)
+	d.Set("usage", q.usage)
+	d.Set("remaining", remaining)
+
+	log.Println("[DEBUG] Finish Quota reading")
+
+	return nil
+}
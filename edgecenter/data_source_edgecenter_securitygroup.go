@@ -19,28 +19,32 @@ func dataSourceSecurityGroup() *schema.Resource {
 		Description: "Represent SecurityGroups(Firewall)",
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -167,17 +171,18 @@ func dataSourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.SetId(sg.ID)
-	_ = d.Set("project_id", sg.ProjectID)
-	_ = d.Set("region_id", sg.RegionID)
-	_ = d.Set("name", sg.Name)
-	_ = d.Set("id", sg.ID)
-	_ = d.Set("description", sg.Description)
-
-	metadataReadOnly := PrepareMetadataReadonly(sg.Metadata)
-	if err := d.Set("metadata_read_only", metadataReadOnly); err != nil {
+	if err := setDataSourceFields(d, flattenSecurityGroupData(sg)); err != nil {
 		return diag.FromErr(err)
 	}
 
+	log.Println("[DEBUG] Finish SecurityGroup reading")
+
+	return diags
+}
+
+// flattenSecurityGroupData returns the attributes of the security group, shared by edgecenter_securitygroup
+// and edgecenter_securitygroups.
+func flattenSecurityGroupData(sg *edgecloudV2.SecurityGroup) map[string]interface{} {
 	newSgRules := make([]interface{}, len(sg.SecurityGroupRules))
 	for i, sgr := range sg.SecurityGroupRules {
 		r := make(map[string]interface{})
@@ -220,11 +225,13 @@ func dataSourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m
 		newSgRules[i] = r
 	}
 
-	if err := d.Set("security_group_rules", schema.NewSet(secGroupUniqueID, newSgRules)); err != nil {
-		return diag.FromErr(err)
+	return map[string]interface{}{
+		"project_id":           sg.ProjectID,
+		"region_id":            sg.RegionID,
+		"name":                 sg.Name,
+		"id":                   sg.ID,
+		"description":          sg.Description,
+		"metadata_read_only":   PrepareMetadataReadonly(sg.Metadata),
+		"security_group_rules": schema.NewSet(secGroupUniqueID, newSgRules),
 	}
-
-	log.Println("[DEBUG] Finish SecurityGroup reading")
-
-	return diags
 }
@@ -0,0 +1,64 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func dataSourceSecurityGroups() *schema.Resource {
+	item := computedElem(dataSourceSecurityGroup().Schema, ProjectNameField, RegionNameField, MetadataKField, MetadataKVField)
+	item.Schema[NameField].Description = "The name of the security group."
+	item.Schema[IDField].Description = "The ID of the security group."
+
+	return &schema.Resource{
+		ReadContext: dataSourceSecurityGroupsRead,
+		Description: "Represent the security groups of a project in a region, filtered by name and metadata.",
+		Schema: listDataSourceSchema(SecurityGroupsField,
+			"The list of the security groups, shaped like the 'edgecenter_securitygroup' data source.", item, false),
+	}
+}
+
+func dataSourceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start SecurityGroups reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	metadataKV, err := filter.metadataKVQuery()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sgs, _, err := clientV2.SecurityGroups.List(ctx, &edgecloudV2.SecurityGroupListOptions{MetadataK: filter.metadataK, MetadataKV: metadataKV})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := make([]map[string]interface{}, 0, len(sgs))
+	for i := range sgs {
+		sg := &sgs[i]
+		if !filter.match(sg.Name, "", metadataDetailedToMap(sg.Metadata)) {
+			continue
+		}
+		items = append(items, flattenSecurityGroupData(sg))
+	}
+
+	if err := setListDataSource(d, clientV2, SecurityGroupsField, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finish SecurityGroups reading, found %d", len(items))
+
+	return nil
+}
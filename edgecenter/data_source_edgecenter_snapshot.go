@@ -2,6 +2,7 @@ package edgecenter
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,32 +17,32 @@ func dataSourceSnapshot() *schema.Resource {
 		Description: "A snapshot is a feature that allows you to capture the current state of the instance or volume at a specific point in time",
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Computed:      true,
+				Optional:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Computed:      true,
+				Optional:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Computed:      true,
+				Optional:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Computed:      true,
+				Optional:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -119,23 +120,38 @@ func dataSourceSnapshotRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	setSnapshotData(d, snapshot)
+	d.SetId(snapshot.ID)
+	if err := setDataSourceFields(d, flattenSnapshotData(snapshot)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Println("[DEBUG] Finish snapshot reading")
 
 	return nil
 }
 
-func setSnapshotData(d *schema.ResourceData, snapshot *edgecloudV2.Snapshot) {
-	d.SetId(snapshot.ID)
-	_ = d.Set("name", snapshot.Name)
-	_ = d.Set("updated_at", snapshot.UpdatedAt)
-	_ = d.Set("created_at", snapshot.CreatedAt)
-	_ = d.Set("status", snapshot.Status)
-	_ = d.Set("creator_task_id", snapshot.CreatorTaskID)
-	_ = d.Set("size", snapshot.Size)
-	_ = d.Set("volume_id", snapshot.VolumeID)
-	_ = d.Set("description", snapshot.Description)
-	_ = d.Set("snapshot_id", snapshot.ID)
-	_ = d.Set("metadata", snapshot.Metadata)
+// flattenSnapshotData returns the attributes of the snapshot, shared by edgecenter_snapshot and edgecenter_snapshots.
+func flattenSnapshotData(snapshot *edgecloudV2.Snapshot) map[string]interface{} {
+	return map[string]interface{}{
+		"name":            snapshot.Name,
+		"updated_at":      snapshot.UpdatedAt,
+		"created_at":      snapshot.CreatedAt,
+		"status":          string(snapshot.Status),
+		"creator_task_id": snapshot.CreatorTaskID,
+		"size":            snapshot.Size,
+		"volume_id":       snapshot.VolumeID,
+		"description":     snapshot.Description,
+		"snapshot_id":     snapshot.ID,
+		"metadata":        snapshotMetadataMap(snapshot),
+	}
+}
+
+// snapshotMetadataMap returns the metadata of the snapshot as strings.
+func snapshotMetadataMap(snapshot *edgecloudV2.Snapshot) map[string]string {
+	metadata := make(map[string]string, len(snapshot.Metadata))
+	for key, value := range snapshot.Metadata {
+		metadata[key] = fmt.Sprint(value)
+	}
+
+	return metadata
 }
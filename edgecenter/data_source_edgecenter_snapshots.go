@@ -0,0 +1,121 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func dataSourceSnapshots() *schema.Resource {
+	item := computedElem(dataSourceSnapshot().Schema, ProjectIDField, ProjectNameField, RegionIDField, RegionNameField)
+	item.Schema[NameField].Description = "The name of the snapshot."
+	item.Schema[SnapshotIDField].Description = "The ID of the snapshot."
+
+	s := listDataSourceSchema(SnapshotsField, "The list of the snapshots, shaped like the 'edgecenter_snapshot' data source, newest first.", item, true)
+	s[VolumeIDField] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The ID of the volume to return the snapshots of.",
+	}
+	s[LifecyclePolicyIDField] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		Description: "The ID of the lifecycle policy to return the snapshots of: the snapshots of the policy volumes " +
+			"whose names follow the 'resource_name_template' of one of the policy schedules.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSnapshotsRead,
+		Description: "Represent the snapshots of a project in a region, filtered by volume, lifecycle policy, name, status and metadata.",
+		Schema:      s,
+	}
+}
+
+func dataSourceSnapshotsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Snapshots reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	volumeID := d.Get(VolumeIDField).(string)
+	var snapshots []edgecloudV2.Snapshot
+	if policyID, ok := d.GetOk(LifecyclePolicyIDField); ok {
+		snapshots, err = listLifecyclePolicySnapshots(ctx, clientV2, policyID.(int), volumeID)
+	} else {
+		snapshots, _, err = clientV2.Snapshots.List(ctx, &edgecloudV2.SnapshotListOptions{VolumeID: volumeID})
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	slices.SortStableFunc(snapshots, func(a, b edgecloudV2.Snapshot) int {
+		switch {
+		case a.CreatedAt > b.CreatedAt:
+			return -1
+		case a.CreatedAt < b.CreatedAt:
+			return 1
+		}
+		return 0
+	})
+
+	items := make([]map[string]interface{}, 0, len(snapshots))
+	for i := range snapshots {
+		snapshot := &snapshots[i]
+		if !filter.match(snapshot.Name, string(snapshot.Status), snapshotMetadataMap(snapshot)) {
+			continue
+		}
+		items = append(items, flattenSnapshotData(snapshot))
+	}
+
+	if err := setListDataSource(d, clientV2, SnapshotsField, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finish Snapshots reading, found %d", len(items))
+
+	return nil
+}
+
+// listLifecyclePolicySnapshots returns the snapshots taken by the lifecycle policy, optionally of one of its volumes only.
+func listLifecyclePolicySnapshots(ctx context.Context, clientV2 *edgecloudV2.Client, policyID int, volumeID string) ([]edgecloudV2.Snapshot, error) {
+	policy, _, err := clientV2.LifeCyclePolicies.Get(ctx, policyID, &edgecloudV2.LifeCyclePolicyGetOptions{NeedVolumes: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get lifecycle policy %d: %w", policyID, err)
+	}
+
+	templates := make([]string, 0, len(policy.Schedules))
+	for _, schedule := range policy.Schedules {
+		templates = append(templates, schedule.GetCommonSchedule().ResourceNameTemplate)
+	}
+
+	var snapshots []edgecloudV2.Snapshot
+	for _, volume := range policy.Volumes {
+		if volumeID != "" && volume.ID != volumeID {
+			continue
+		}
+		volumeSnapshots, _, err := clientV2.Snapshots.List(ctx, &edgecloudV2.SnapshotListOptions{VolumeID: volume.ID})
+		if err != nil {
+			return nil, fmt.Errorf("cannot list the snapshots of volume %s: %w", volume.ID, err)
+		}
+		for _, snapshot := range volumeSnapshots {
+			if lifecyclePolicySnapshotMatches(templates, volume.ID, snapshot.Name) {
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+	}
+
+	return snapshots, nil
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 )
 
 func dataSourceSubnet() *schema.Resource {
@@ -13,28 +15,32 @@ func dataSourceSubnet() *schema.Resource {
 		ReadContext: dataSourceSubnetRead,
 		Schema: map[string]*schema.Schema{
 			ProjectIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectNameField},
 			},
 			ProjectNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectIDField},
 			},
 			RegionIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionNameField},
 			},
 			RegionNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionIDField},
 			},
 			IDField: {
 				Type:         schema.TypeString,
@@ -161,36 +167,37 @@ func dataSourceSubnetRead(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	d.SetId(subnet.ID)
-	_ = d.Set(NameField, subnet.Name)
-	_ = d.Set(IDField, subnet.ID)
-	_ = d.Set(EnableDHCPField, subnet.EnableDHCP)
-	_ = d.Set(CIDRField, subnet.CIDR)
-	_ = d.Set(NetworkIDField, subnet.NetworkID)
-
-	metadataReadOnly := PrepareMetadataReadonly(subnet.Metadata)
-	if err := d.Set(MetadataReadOnlyField, metadataReadOnly); err != nil {
+	if err := setDataSourceFields(d, flattenSubnetData(subnet)); err != nil {
 		return diag.FromErr(err)
 	}
 
-	_ = d.Set(DNSNameserversField, dnsNameserversToStringList(subnet.DNSNameservers))
-	_ = d.Set(HostRoutesField, hostRoutesToListOfMapsV2(subnet.HostRoutes))
-	_ = d.Set(RegionIDField, subnet.RegionID)
-	_ = d.Set(ProjectIDField, subnet.ProjectID)
-	_ = d.Set(ConnectToNetworkRouterField, true)
-	if subnet.GatewayIP != nil {
-		_ = d.Set(GatewayIPField, subnet.GatewayIP.String())
-	} else {
-		_ = d.Set(GatewayIPField, "disable")
-		_ = d.Set(ConnectToNetworkRouterField, false)
-	}
+	log.Println("[DEBUG] Finish Subnet reading")
 
-	allocationPoolsSet := d.Get(AllocationPoolsField).(*schema.Set)
+	return nil
+}
 
-	if err := d.Set(AllocationPoolsField, schema.NewSet(allocationPoolsSet.F, allocationPoolsToListOfMaps(subnet.AllocationPools))); err != nil {
-		return diag.FromErr(err)
+// flattenSubnetData returns the attributes of the subnet, shared by edgecenter_subnet and edgecenter_subnets.
+func flattenSubnetData(subnet *edgecloudV2.Subnetwork) map[string]interface{} {
+	fields := map[string]interface{}{
+		NameField:                   subnet.Name,
+		IDField:                     subnet.ID,
+		EnableDHCPField:             subnet.EnableDHCP,
+		CIDRField:                   subnet.CIDR,
+		NetworkIDField:              subnet.NetworkID,
+		MetadataReadOnlyField:       PrepareMetadataReadonly(subnet.Metadata),
+		DNSNameserversField:         dnsNameserversToStringList(subnet.DNSNameservers),
+		HostRoutesField:             hostRoutesToListOfMapsV2(subnet.HostRoutes),
+		RegionIDField:               subnet.RegionID,
+		ProjectIDField:              subnet.ProjectID,
+		ConnectToNetworkRouterField: true,
+		GatewayIPField:              "disable",
+		AllocationPoolsField:        allocationPoolsToListOfMaps(subnet.AllocationPools),
+	}
+	if subnet.GatewayIP != nil {
+		fields[GatewayIPField] = subnet.GatewayIP.String()
+	} else {
+		fields[ConnectToNetworkRouterField] = false
 	}
 
-	log.Println("[DEBUG] Finish Subnet reading")
-
-	return nil
+	return fields
 }
@@ -0,0 +1,73 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func dataSourceSubnets() *schema.Resource {
+	item := computedElem(dataSourceSubnet().Schema, ProjectNameField, RegionNameField, MetadataKField, MetadataKVField)
+	item.Schema[IDField].Description = "The ID of the subnet."
+
+	s := listDataSourceSchema(SubnetsField, "The list of the subnets, shaped like the 'edgecenter_subnet' data source.", item, false)
+	s[NetworkIDField] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return the subnets of this network.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSubnetsRead,
+		Description: "Represent the subnets of a project in a region, filtered by network, name and metadata.",
+		Schema:      s,
+	}
+}
+
+func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Subnets reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	metadataKV, err := filter.metadataKVQuery()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subnets, _, err := clientV2.Subnetworks.List(ctx, &edgecloudV2.SubnetworkListOptions{
+		NetworkID:  d.Get(NetworkIDField).(string),
+		MetadataK:  filter.metadataK,
+		MetadataKV: metadataKV,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := make([]map[string]interface{}, 0, len(subnets))
+	for i := range subnets {
+		subnet := &subnets[i]
+		if !filter.match(subnet.Name, "", metadataDetailedToMap(subnet.Metadata)) {
+			continue
+		}
+		items = append(items, flattenSubnetData(subnet))
+	}
+
+	if err := setListDataSource(d, clientV2, SubnetsField, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finish Subnets reading, found %d", len(items))
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 )
 
 func dataSourceVolume() *schema.Resource {
@@ -15,28 +17,32 @@ func dataSourceVolume() *schema.Resource {
 Volumes can be attached to a virtual machine and manipulated like a physical hard drive.`,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -75,6 +81,11 @@ Volumes can be attached to a virtual machine and manipulated like a physical har
 				Computed:    true,
 				Description: "The type of volume to create. Valid values are 'ssd_hiiops', 'standard', 'cold', and 'ultra'. Defaults to 'standard'.",
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the volume, e.g. 'available' or 'in-use'.",
+			},
 			"metadata_read_only": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -115,15 +126,7 @@ func dataSourceVolumeRead(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	d.SetId(volume.ID)
-	_ = d.Set("name", volume.Name)
-	_ = d.Set("id", volume.ID)
-	_ = d.Set("size", volume.Size)
-	_ = d.Set("type_name", volume.VolumeType)
-	_ = d.Set("region_id", volume.RegionID)
-	_ = d.Set("project_id", volume.ProjectID)
-
-	metadataReadOnly := PrepareMetadataReadonly(volume.MetadataDetailed)
-	if err := d.Set("metadata_read_only", metadataReadOnly); err != nil {
+	if err := setDataSourceFields(d, flattenVolumeData(volume)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -131,3 +134,17 @@ func dataSourceVolumeRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	return diags
 }
+
+// flattenVolumeData returns the attributes of the volume, shared by edgecenter_volume and edgecenter_volumes.
+func flattenVolumeData(volume *edgecloudV2.Volume) map[string]interface{} {
+	return map[string]interface{}{
+		"name":               volume.Name,
+		"id":                 volume.ID,
+		"size":               volume.Size,
+		"type_name":          string(volume.VolumeType),
+		"status":             string(volume.Status),
+		"region_id":          volume.RegionID,
+		"project_id":         volume.ProjectID,
+		"metadata_read_only": PrepareMetadataReadonly(volume.MetadataDetailed),
+	}
+}
@@ -0,0 +1,62 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func dataSourceVolumes() *schema.Resource {
+	item := computedElem(dataSourceVolume().Schema, ProjectNameField, RegionNameField, MetadataKField, MetadataKVField)
+	item.Schema[NameField].Description = "The name of the volume."
+	item.Schema[IDField].Description = "The ID of the volume."
+
+	return &schema.Resource{
+		ReadContext: dataSourceVolumesRead,
+		Description: "Represent the volumes of a project in a region, filtered by name, status and metadata.",
+		Schema:      listDataSourceSchema(VolumesField, "The list of the volumes, shaped like the 'edgecenter_volume' data source.", item, true),
+	}
+}
+
+func dataSourceVolumesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Volumes reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	volumes, err := listAllPages(func(limit, offset int) ([]edgecloudV2.Volume, error) {
+		page, _, err := clientV2.Volumes.List(ctx, &edgecloudV2.VolumeListOptions{Limit: limit, Offset: offset})
+		return page, err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := make([]map[string]interface{}, 0, len(volumes))
+	for i := range volumes {
+		volume := &volumes[i]
+		if !filter.match(volume.Name, string(volume.Status), metadataDetailedToMap(volume.MetadataDetailed)) {
+			continue
+		}
+		items = append(items, flattenVolumeData(volume))
+	}
+
+	if err := setListDataSource(d, clientV2, VolumesField, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finish Volumes reading, found %d", len(items))
+
+	return nil
+}
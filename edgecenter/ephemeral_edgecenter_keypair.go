@@ -0,0 +1,204 @@
+package edgecenter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// keypairPrivateKey is the key of the private data that tells Close which key pair to delete.
+const keypairPrivateKey = "keypair"
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = (*keypairEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithClose     = (*keypairEphemeralResource)(nil)
+)
+
+// keypairEphemeralResource generates a key pair whose private key is never written to the state or the plan.
+type keypairEphemeralResource struct {
+	config *Config
+}
+
+type keypairEphemeralModel struct {
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	ProjectName types.String `tfsdk:"project_name"`
+	SSHKeyName  types.String `tfsdk:"sshkey_name"`
+	SSHKeyID    types.String `tfsdk:"sshkey_id"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	PrivateKey  types.String `tfsdk:"private_key"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+}
+
+type keypairPrivateData struct {
+	ProjectID int    `json:"project_id"`
+	SSHKeyID  string `json:"sshkey_id"`
+}
+
+func NewKeypairEphemeralResource() ephemeral.EphemeralResource {
+	return &keypairEphemeralResource{}
+}
+
+func (r *keypairEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keypair"
+}
+
+func (r *keypairEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Generates an ssh key pair that exists while Terraform runs. The key pair is created when Terraform opens the ephemeral resource
+and deleted when it closes it, its private key is never written to the plan or the state.`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+			},
+			"project_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+			},
+			"sshkey_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name assigned to the SSH key pair, used for identification purposes.",
+			},
+			"sshkey_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier assigned by the provider to the SSH key pair.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public portion of the generated SSH key pair.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private portion of the generated SSH key pair.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "A fingerprint of the SSH public key, used to verify the integrity of the key.",
+			},
+		},
+	}
+}
+
+func (r *keypairEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", fmt.Sprintf("Expected *edgecenter.Config, got %T.", req.ProviderData))
+		return
+	}
+	r.config = config
+}
+
+func (r *keypairEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	log.Println("[DEBUG] Start ephemeral KeyPair opening")
+
+	if r.config == nil {
+		resp.Diagnostics.AddError("Unable to Generate Key Pair", "The provider is not configured.")
+		return
+	}
+
+	var data keypairEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(data.ProjectID.ValueInt64())
+	projectName := data.ProjectName.ValueString()
+	if projectID == 0 && projectName == "" {
+		projectID, projectName = r.config.DefaultProjectID, r.config.DefaultProjectName
+	}
+	if projectID == 0 && projectName == "" {
+		resp.Diagnostics.AddError("Unable to Generate Key Pair", "Neither project_id nor project_name is provided in the ephemeral resource or the provider configuration.")
+		return
+	}
+
+	clientV2, err := r.keypairClient(ctx, projectID, projectName)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Generate Key Pair", err.Error())
+		return
+	}
+
+	// Without a public key, the API generates the key pair and returns its private key.
+	kp, _, err := clientV2.KeyPairs.CreateV2(ctx, &edgecloudV2.KeyPairCreateRequestV2{
+		SSHKeyName: data.SSHKeyName.ValueString(),
+		ProjectID:  clientV2.Project,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Generate Key Pair", err.Error())
+		return
+	}
+
+	private, err := json.Marshal(keypairPrivateData{ProjectID: clientV2.Project, SSHKeyID: kp.SSHKeyID})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Generate Key Pair", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, keypairPrivateKey, private)...)
+
+	data.ProjectID = types.Int64Value(int64(clientV2.Project))
+	data.SSHKeyID = types.StringValue(kp.SSHKeyID)
+	data.PublicKey = types.StringValue(kp.PublicKey)
+	data.PrivateKey = types.StringValue(kp.PrivateKey)
+	data.Fingerprint = types.StringValue(kp.Fingerprint)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	log.Printf("[DEBUG] Finish ephemeral KeyPair opening (%s)", kp.SSHKeyID)
+}
+
+func (r *keypairEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	log.Println("[DEBUG] Start ephemeral KeyPair closing")
+
+	raw, diags := req.Private.GetKey(ctx, keypairPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var private keypairPrivateData
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError("Unable to Delete Key Pair", err.Error())
+		return
+	}
+
+	clientV2, err := r.keypairClient(ctx, private.ProjectID, "")
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Delete Key Pair", err.Error())
+		return
+	}
+	if _, err := clientV2.KeyPairs.DeleteV2(ctx, private.SSHKeyID); err != nil {
+		resp.Diagnostics.AddError("Unable to Delete Key Pair", fmt.Sprintf("Key pair %s: %s", private.SSHKeyID, err))
+		return
+	}
+
+	log.Printf("[DEBUG] Finish ephemeral KeyPair closing (%s)", private.SSHKeyID)
+}
+
+func (r *keypairEphemeralResource) keypairClient(ctx context.Context, projectID int, projectName string) (*edgecloudV2.Client, error) {
+	clientV2, err := r.config.NewCloudClient()
+	if err != nil {
+		return nil, err
+	}
+	clientV2.Project, err = resolveProjectID(ctx, r.config, clientV2, projectID, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	// To work with KeyPairsV2 endpoints, you only need a project.
+	// Therefore, a stub with a value of 1 is applied for the region.
+	clientV2.Region = 1
+
+	return clientV2, nil
+}
@@ -0,0 +1,208 @@
+//go:build integration
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud"
+	cloudmock "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud/mock"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/provider"
+)
+
+func floatingIPAssociationCreateCase(fipID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.Floatingips.On("Assign", mock.Anything, fipID,
+		mock.MatchedBy(func(req *edgecloud.AssignFloatingIPRequest) bool {
+			return req.PortID == "port-1" && req.FixedIPAddress == nil
+		}),
+	).Return((*edgecloud.FloatingIP)(nil), nil, nil)
+
+	assignedIP := sampleFloatingIP(fipID, "10.0.0.1", "ACTIVE", "port-1")
+	assignedIP.FixedIPAddress = net.ParseIP("192.168.10.5")
+
+	mc.Floatingips.On("Get", mock.Anything, fipID).
+		Return(&assignedIP, nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:    "successful create",
+		Op:      support.OpApply,
+		Prepare: func() *cloudmock.MockedCloud { return mc },
+		NewConfig: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"floating_ip_id": fipID,
+				"port_id":        "port-1",
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, fipID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"floating_ip_id":      fipID,
+				"port_id":             "port-1",
+				"fixed_ip_address":    "192.168.10.5",
+				"floating_ip_address": "10.0.0.1",
+			})
+		},
+	}
+}
+
+func floatingIPAssociationRetargetCase(fipID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.Floatingips.On("UnAssign", mock.Anything, fipID).
+		Return((*edgecloud.FloatingIP)(nil), nil, nil).Once()
+
+	mc.Floatingips.On("Assign", mock.Anything, fipID,
+		mock.MatchedBy(func(req *edgecloud.AssignFloatingIPRequest) bool {
+			return req.PortID == "lb-vip-port" && req.FixedIPAddress == nil
+		}),
+	).Return((*edgecloud.FloatingIP)(nil), nil, nil).Once()
+
+	retargetedIP := sampleFloatingIP(fipID, "10.0.0.1", "ACTIVE", "lb-vip-port")
+	retargetedIP.FixedIPAddress = net.ParseIP("10.20.0.4")
+
+	mc.Floatingips.On("Get", mock.Anything, fipID).
+		Return(&retargetedIP, nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "retarget floating IP to load balancer VIP port",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: fipID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"floating_ip_id":   fipID,
+				"port_id":          "port-1",
+				"fixed_ip_address": "192.168.10.5",
+			},
+		),
+		NewConfig: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"floating_ip_id": fipID,
+				"port_id":        "lb-vip-port",
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, fipID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"port_id":          "lb-vip-port",
+				"fixed_ip_address": "10.20.0.4",
+			})
+		},
+	}
+}
+
+func floatingIPAssociationReadUnassignedCase(fipID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	unassignedIP := sampleFloatingIP(fipID, "10.0.0.1", "DOWN", "")
+
+	mc.Floatingips.On("Get", mock.Anything, fipID).
+		Return(&unassignedIP, nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "read floating IP assigned out of band is removed from state",
+		Op:        support.OpRead,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: fipID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"floating_ip_id": fipID,
+				"port_id":        "port-1",
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			require.Nil(t, state, "state must be nil when floating IP is not assigned")
+		},
+	}
+}
+
+func floatingIPAssociationReadNotFoundCase(fipID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Floatingips.On("Get", mock.Anything, fipID).
+		Return(nil, &edgecloud.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, fmt.Errorf("not found"))
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "read deleted floating IP",
+		Op:        support.OpRead,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: fipID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"floating_ip_id": fipID,
+				"port_id":        "port-1",
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			require.Nil(t, state, "state must be nil when floating IP not found")
+		},
+	}
+}
+
+func floatingIPAssociationDeleteCase(fipID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Floatingips.On("UnAssign", mock.Anything, fipID).
+		Return((*edgecloud.FloatingIP)(nil), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "delete unassigns floating IP",
+		Op:        support.OpDelete,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: fipID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"floating_ip_id": fipID,
+				"port_id":        "port-1",
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be nil after delete")
+		},
+	}
+}
+
+func TestIntegrationFloatingIPAssociation_TableDriven(t *testing.T) {
+	t.Parallel()
+
+	resource := provider.Provider().ResourcesMap["edgecenter_floatingip_association"]
+
+	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
+		floatingIPAssociationCreateCase(testFloatingIPID),
+		floatingIPAssociationRetargetCase(testFloatingIPID),
+		floatingIPAssociationReadUnassignedCase(testFloatingIPID),
+		floatingIPAssociationReadNotFoundCase(testFloatingIPID),
+		floatingIPAssociationDeleteCase(testFloatingIPID),
+	}
+
+	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
+}
@@ -152,6 +152,45 @@ func floatingIPUnassignCase(fipID string) support.ResourceCase[*cloudmock.Mocked
 	}
 }
 
+func floatingIPAdoptAssignedPortCase(fipID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	assignedIP := sampleFloatingIP(fipID, "10.0.0.1", "ACTIVE", "port-1")
+	assignedIP.Instance.ID = testInstanceID
+
+	mc.Floatingips.On("List", mock.Anything).
+		Return([]edgecloud.FloatingIP{assignedIP}, nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "set port_id of already assigned floating IP without reassigning",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: fipID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"instance_id_attached_to": testInstanceID,
+				"instance_port_id":        "port-1",
+			},
+		),
+		NewConfig: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"port_id": "port-1",
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, fipID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"port_id":                 "port-1",
+				"instance_id_attached_to": testInstanceID,
+			})
+		},
+	}
+}
+
 func floatingIPUpdateMetadataCase(fipID string) support.ResourceCase[*cloudmock.MockedCloud] {
 	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
 	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
@@ -316,6 +355,7 @@ func TestIntegrationFloatingIP_TableDriven(t *testing.T) {
 		floatingIPAssignCase(testFloatingIPID),
 		floatingIPUnassignCase(testFloatingIPID),
 		floatingIPReassignAfterNotFoundUnassignCase(testFloatingIPID),
+		floatingIPAdoptAssignedPortCase(testFloatingIPID),
 		floatingIPUpdateMetadataCase(testFloatingIPID),
 		floatingIPDeleteCase(testFloatingIPID),
 		floatingIPCreateAPIFailureCase(),
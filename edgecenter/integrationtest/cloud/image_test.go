@@ -0,0 +1,209 @@
+//go:build integration
+
+package edgecenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud"
+	cloudmock "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud/mock"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/provider"
+)
+
+const testImageID = "img-id"
+
+func sampleImage(id, name string) *edgecloud.Image {
+	return &edgecloud.Image{
+		ID:             id,
+		Name:           name,
+		Status:         "active",
+		DiskFormat:     "qcow2",
+		Size:           2361393152,
+		MinDisk:        3,
+		OSDistro:       "ubuntu",
+		OSVersion:      "22.04",
+		OSType:         "linux",
+		SSHKey:         "allow",
+		HwMachineType:  "q35",
+		HwFirmwareType: "bios",
+	}
+}
+
+// sampleWindowsImage differs from the schema defaults in every image property.
+func sampleWindowsImage(id, name string) *edgecloud.Image {
+	image := sampleImage(id, name)
+	image.OSType = "windows"
+	image.SSHKey = "deny"
+	image.HwMachineType = "i440"
+	image.HwFirmwareType = "uefi"
+
+	return image
+}
+
+func imageCreateFromURLCase(imageID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.Images.On("Upload", mock.Anything,
+		mock.MatchedBy(func(req *edgecloud.ImageUploadRequest) bool {
+			return req.Name == "test-image" && req.URL == "https://example.com/ubuntu.qcow2" &&
+				string(req.HwFirmwareType) == "bios" && req.IsBaremetal != nil && !*req.IsBaremetal
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-image-1"}}, nil, nil)
+
+	mc.Tasks.On("Get", mock.Anything, "task-image-1").
+		Return(&edgecloud.Task{
+			State: edgecloud.TaskStateFinished,
+			CreatedResources: map[string]interface{}{
+				"images": []interface{}{imageID},
+			},
+		}, nil, nil)
+
+	mc.Images.On("Get", mock.Anything, imageID).
+		Return(sampleImage(imageID, "test-image"), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:    "create from url",
+		Op:      support.OpApply,
+		Prepare: func() *cloudmock.MockedCloud { return mc },
+		NewConfig: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			cloud.WithName("test-image"),
+			map[string]interface{}{
+				"url":        "https://example.com/ubuntu.qcow2",
+				"os_distro":  "ubuntu",
+				"os_version": "22.04",
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, imageID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"name":             "test-image",
+				"status":           "active",
+				"disk_format":      "qcow2",
+				"os_type":          "linux",
+				"ssh_key":          "allow",
+				"hw_machine_type":  "q35",
+				"hw_firmware_type": "bios",
+				"is_baremetal":     "false",
+			})
+		},
+	}
+}
+
+func imageReadDriftCase(imageID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Images.On("Get", mock.Anything, imageID).
+		Return(sampleWindowsImage(imageID, "test-image"), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "read properties changed outside terraform",
+		Op:        support.OpRead,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: imageID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			cloud.WithName("test-image"),
+			map[string]interface{}{"url": "https://example.com/ubuntu.qcow2"},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"os_type":          "windows",
+				"ssh_key":          "deny",
+				"hw_machine_type":  "i440",
+				"hw_firmware_type": "uefi",
+			})
+		},
+	}
+}
+
+func imageDeleteCase(imageID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Images.On("Delete", mock.Anything, imageID).
+		Return(&edgecloud.TaskResponse{Tasks: []string{"task-del"}}, nil, nil)
+
+	mc.Tasks.On("Get", mock.Anything, "task-del").
+		Return(&edgecloud.Task{State: edgecloud.TaskStateFinished}, nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "delete image",
+		Op:        support.OpDelete,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: imageID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			cloud.WithName("test-image"),
+			map[string]interface{}{"url": "https://example.com/ubuntu.qcow2"},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be nil after delete")
+		},
+	}
+}
+
+func TestIntegrationImage_TableDriven(t *testing.T) {
+	t.Parallel()
+
+	resource := provider.Provider().ResourcesMap["edgecenter_image"]
+
+	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
+		imageCreateFromURLCase(testImageID),
+		imageReadDriftCase(testImageID),
+		imageDeleteCase(testImageID),
+	}
+
+	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
+}
+
+// TestIntegrationImage_Import checks that an imported image gets its properties from the API
+// rather than the schema defaults.
+func TestIntegrationImage_Import(t *testing.T) {
+	t.Parallel()
+
+	resource := provider.Provider().ResourcesMap["edgecenter_image"]
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	t.Cleanup(func() { mc.MockCleanup(t) })
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Images.On("Get", mock.Anything, testImageID).
+		Return(sampleWindowsImage(testImageID, "imported-image"), nil, nil)
+
+	ctx := context.Background()
+	data := resource.Data(&terraform.InstanceState{ID: fmt.Sprintf("%d:%d:%s", testProjectID, testRegionID, testImageID)})
+	imported, err := resource.Importer.StateContext(ctx, data, mc.Config)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+
+	diags := resource.ReadContext(ctx, imported[0], mc.Config)
+	support.RequireNoDiags(t, diags)
+
+	state := imported[0].State()
+	support.RequireStateID(t, state, testImageID)
+	support.RequireStateAttrs(t, state, map[string]string{
+		"name":             "imported-image",
+		"project_id":       fmt.Sprint(testProjectID),
+		"region_id":        fmt.Sprint(testRegionID),
+		"os_type":          "windows",
+		"ssh_key":          "deny",
+		"hw_machine_type":  "i440",
+		"hw_firmware_type": "uefi",
+		"is_baremetal":     "false",
+	})
+}
@@ -0,0 +1,127 @@
+//go:build integration
+
+package edgecenter_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+	cloudmock "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud/mock"
+)
+
+// ephemeralTestProvider serves the ephemeral resources with the mocked configuration.
+type ephemeralTestProvider struct {
+	config *edgecenter.Config
+}
+
+func (p *ephemeralTestProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "edgecenter"
+}
+
+func (p *ephemeralTestProvider) Schema(context.Context, fwprovider.SchemaRequest, *fwprovider.SchemaResponse) {
+}
+
+func (p *ephemeralTestProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	resp.EphemeralResourceData = p.config
+}
+
+func (p *ephemeralTestProvider) Resources(context.Context) []func() resource.Resource { return nil }
+
+func (p *ephemeralTestProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *ephemeralTestProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{edgecenter.NewKeypairEphemeralResource}
+}
+
+var keypairEphemeralType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"project_id":   tftypes.Number,
+	"project_name": tftypes.String,
+	"sshkey_name":  tftypes.String,
+	"sshkey_id":    tftypes.String,
+	"public_key":   tftypes.String,
+	"private_key":  tftypes.String,
+	"fingerprint":  tftypes.String,
+}}
+
+func TestIntegrationKeypairEphemeral_OpenAndClose(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	t.Cleanup(func() { mc.MockCleanup(t) })
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	generated := sampleKeypair(testKeypairID, "ephemeral-key", testPublicKey, testFingerprint)
+	generated.PrivateKey = testPrivateKey
+
+	mc.KeyPairs.On("CreateV2", mock.Anything,
+		mock.MatchedBy(func(req *edgecloud.KeyPairCreateRequestV2) bool {
+			return req.SSHKeyName == "ephemeral-key" && req.PublicKey == "" && req.ProjectID == testProjectID
+		}),
+	).Return(generated, nil, nil).Once()
+	mc.KeyPairs.On("DeleteV2", mock.Anything, testKeypairID).
+		Return(nil, nil).Once()
+
+	server := providerserver.NewProtocol5(&ephemeralTestProvider{config: mc.Config})()
+
+	providerConfig, err := tfprotov5.NewDynamicValue(tftypes.Object{}, tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}))
+	require.NoError(t, err)
+	configured, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &providerConfig})
+	require.NoError(t, err)
+	require.Empty(t, configured.Diagnostics)
+
+	config, err := tfprotov5.NewDynamicValue(keypairEphemeralType, tftypes.NewValue(keypairEphemeralType, map[string]tftypes.Value{
+		"project_id":   tftypes.NewValue(tftypes.Number, testProjectID),
+		"project_name": tftypes.NewValue(tftypes.String, nil),
+		"sshkey_name":  tftypes.NewValue(tftypes.String, "ephemeral-key"),
+		"sshkey_id":    tftypes.NewValue(tftypes.String, nil),
+		"public_key":   tftypes.NewValue(tftypes.String, nil),
+		"private_key":  tftypes.NewValue(tftypes.String, nil),
+		"fingerprint":  tftypes.NewValue(tftypes.String, nil),
+	}))
+	require.NoError(t, err)
+
+	opened, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "edgecenter_keypair",
+		Config:   &config,
+	})
+	require.NoError(t, err)
+	require.Empty(t, opened.Diagnostics)
+
+	result, err := opened.Result.Unmarshal(keypairEphemeralType)
+	require.NoError(t, err)
+	var attrs map[string]tftypes.Value
+	require.NoError(t, result.As(&attrs))
+	for name, want := range map[string]string{
+		"sshkey_id":   testKeypairID,
+		"public_key":  testPublicKey,
+		"private_key": testPrivateKey,
+	} {
+		var got string
+		require.NoError(t, attrs[name].As(&got))
+		require.Equal(t, want, got, name)
+	}
+
+	closed, err := server.CloseEphemeralResource(ctx, &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "edgecenter_keypair",
+		Private:  opened.Private,
+	})
+	require.NoError(t, err)
+	require.Empty(t, closed.Diagnostics)
+}
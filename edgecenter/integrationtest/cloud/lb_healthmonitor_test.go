@@ -0,0 +1,196 @@
+//go:build integration
+
+package edgecenter_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud"
+	cloudmock "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud/mock"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/provider"
+)
+
+const (
+	testHealthMonitorID     = "test-health-monitor-id"
+	testHealthMonitorPoolID = "0f3a8c61-5d2e-4b6f-9a1c-7e4d2b8f6a10"
+)
+
+func samplePoolWithHealthMonitor(poolID, healthMonitorID, urlPath string) *edgecloud.Pool {
+	method := edgecloud.HTTPMethodGET
+	return &edgecloud.Pool{
+		ID:                    poolID,
+		Name:                  "test-pool",
+		LoadbalancerAlgorithm: edgecloud.LoadbalancerAlgorithmRoundRobin,
+		Protocol:              edgecloud.LBPoolProtocolHTTP,
+		HealthMonitor: &edgecloud.HealthMonitor{
+			ID:             healthMonitorID,
+			Type:           edgecloud.HealthMonitorTypeHTTP,
+			Delay:          10,
+			Timeout:        5,
+			MaxRetries:     3,
+			MaxRetriesDown: 3,
+			HTTPMethod:     &method,
+			URLPath:        urlPath,
+			ExpectedCodes:  "200",
+		},
+	}
+}
+
+func healthMonitorConfig(urlPath string) map[string]interface{} {
+	return cloud.Merge(
+		cloud.WithProjectRegion(testProjectID, testRegionID),
+		map[string]interface{}{
+			"pool_id":     testHealthMonitorPoolID,
+			"type":        "HTTP",
+			"delay":       10,
+			"timeout":     5,
+			"max_retries": 3,
+			"url_path":    urlPath,
+		},
+	)
+}
+
+func healthMonitorCreateCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.Loadbalancers.On("HealthMonitorCreate", mock.Anything, testHealthMonitorPoolID,
+		mock.MatchedBy(func(req *edgecloud.HealthMonitorCreateRequest) bool {
+			return req.Type == edgecloud.HealthMonitorTypeHTTP &&
+				req.Delay == 10 &&
+				req.Timeout == 5 &&
+				req.MaxRetries == 3 &&
+				req.URLPath == "/health" &&
+				req.ID == ""
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-hm-create"}}, nil, nil)
+
+	mc.Tasks.On("Get", mock.Anything, "task-hm-create").
+		Return(&edgecloud.Task{State: edgecloud.TaskStateFinished}, nil, nil)
+
+	mc.Loadbalancers.On("PoolGet", mock.Anything, testHealthMonitorPoolID).
+		Return(samplePoolWithHealthMonitor(testHealthMonitorPoolID, testHealthMonitorID, "/health"), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "successful create",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		NewConfig: healthMonitorConfig("/health"),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, testHealthMonitorID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"url_path":         "/health",
+				"http_method":      "GET",
+				"expected_codes":   "200",
+				"max_retries_down": "3",
+			})
+		},
+	}
+}
+
+func healthMonitorUpdateCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.Loadbalancers.On("PoolGet", mock.Anything, testHealthMonitorPoolID).
+		Return(samplePoolWithHealthMonitor(testHealthMonitorPoolID, testHealthMonitorID, "/health"), nil, nil).Once()
+
+	mc.Loadbalancers.On("PoolUpdate", mock.Anything, testHealthMonitorPoolID,
+		mock.MatchedBy(func(req *edgecloud.PoolUpdateRequest) bool {
+			return req.Name == "test-pool" &&
+				len(req.Members) == 0 &&
+				req.HealthMonitor != nil &&
+				req.HealthMonitor.ID == testHealthMonitorID &&
+				req.HealthMonitor.URLPath == "/ready"
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-hm-update"}}, nil, nil)
+
+	mc.Tasks.On("Get", mock.Anything, "task-hm-update").
+		Return(&edgecloud.Task{State: edgecloud.TaskStateFinished}, nil, nil)
+
+	mc.Loadbalancers.On("PoolGet", mock.Anything, testHealthMonitorPoolID).
+		Return(samplePoolWithHealthMonitor(testHealthMonitorPoolID, testHealthMonitorID, "/ready"), nil, nil).Once()
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "update url path in place",
+		Op:           support.OpApply,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testHealthMonitorID,
+		CurrentState: healthMonitorConfig("/health"),
+		NewConfig:    healthMonitorConfig("/ready"),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, testHealthMonitorID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"url_path": "/ready",
+			})
+		},
+	}
+}
+
+func healthMonitorReadRemovedCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	pool := samplePoolWithHealthMonitor(testHealthMonitorPoolID, testHealthMonitorID, "/health")
+	pool.HealthMonitor = nil
+	mc.Loadbalancers.On("PoolGet", mock.Anything, testHealthMonitorPoolID).
+		Return(pool, nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "read monitor removed from the pool",
+		Op:           support.OpRead,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testHealthMonitorID,
+		CurrentState: healthMonitorConfig("/health"),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			require.Nil(t, state, "state must be nil when the pool has no monitor")
+		},
+	}
+}
+
+func healthMonitorDeleteCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Loadbalancers.On("HealthMonitorDelete", mock.Anything, testHealthMonitorPoolID).
+		Return(&edgecloud.Response{Response: &http.Response{StatusCode: http.StatusNoContent}}, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "delete monitor",
+		Op:           support.OpDelete,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testHealthMonitorID,
+		CurrentState: healthMonitorConfig("/health"),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be nil after delete")
+		},
+	}
+}
+
+func TestIntegrationLBHealthMonitor_TableDriven(t *testing.T) {
+	t.Parallel()
+
+	resource := provider.Provider().ResourcesMap["edgecenter_lb_healthmonitor"]
+
+	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
+		healthMonitorCreateCase(),
+		healthMonitorUpdateCase(),
+		healthMonitorReadRemovedCase(),
+		healthMonitorDeleteCase(),
+	}
+
+	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
+}
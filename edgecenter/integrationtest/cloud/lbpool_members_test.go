@@ -0,0 +1,221 @@
+//go:build integration
+
+package edgecenter_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud"
+	cloudmock "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud/mock"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/provider"
+)
+
+const testPoolMembersPoolID = "6b0d2f4e-8c1a-4e7b-9d3f-2a5c7e9b1d40"
+
+func samplePoolMember(id, address string, weight int) edgecloud.PoolMember {
+	return edgecloud.PoolMember{
+		ID:              id,
+		OperatingStatus: edgecloud.OperatingStatusOnline,
+		PoolMemberCreateRequest: edgecloud.PoolMemberCreateRequest{
+			Address:      net.ParseIP(address),
+			ProtocolPort: 8080,
+			Weight:       weight,
+		},
+	}
+}
+
+func samplePoolWithMembers(members ...edgecloud.PoolMember) *edgecloud.Pool {
+	return &edgecloud.Pool{
+		ID:      testPoolMembersPoolID,
+		Name:    "test-pool",
+		Members: members,
+	}
+}
+
+func poolMembersConfig(weights map[string]int) map[string]interface{} {
+	members := make([]interface{}, 0, len(weights))
+	for address, weight := range weights {
+		members = append(members, map[string]interface{}{
+			"address":       address,
+			"protocol_port": 8080,
+			"weight":        weight,
+		})
+	}
+
+	return cloud.Merge(
+		cloud.WithProjectRegion(testProjectID, testRegionID),
+		map[string]interface{}{
+			"pool_id": testPoolMembersPoolID,
+			"member":  members,
+		},
+	)
+}
+
+func expectPoolMembersTask(mc *cloudmock.MockedCloud, taskID string) {
+	mc.Tasks.On("Get", mock.Anything, taskID).
+		Return(&edgecloud.Task{State: edgecloud.TaskStateFinished}, nil, nil)
+}
+
+func poolMembersCreateCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.Loadbalancers.On("PoolGet", mock.Anything, testPoolMembersPoolID).
+		Return(samplePoolWithMembers(), nil, nil).Once()
+
+	mc.Loadbalancers.On("PoolUpdate", mock.Anything, testPoolMembersPoolID,
+		mock.MatchedBy(func(req *edgecloud.PoolUpdateRequest) bool {
+			return req.Name == "test-pool" && len(req.Members) == 2 &&
+				req.Members[0].ID == "" && req.Members[1].ID == ""
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-members-create"}}, nil, nil).Once()
+	expectPoolMembersTask(mc, "task-members-create")
+
+	mc.Loadbalancers.On("PoolGet", mock.Anything, testPoolMembersPoolID).
+		Return(samplePoolWithMembers(
+			samplePoolMember("member-1", "10.0.0.1", 1),
+			samplePoolMember("member-2", "10.0.0.2", 1),
+		), nil, nil).Once()
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "create all members in one update",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		NewConfig: poolMembersConfig(map[string]int{"10.0.0.1": 1, "10.0.0.2": 1}),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, mc *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, testPoolMembersPoolID)
+			support.RequireStateAttrs(t, state, map[string]string{"member.#": "2"})
+			mc.Loadbalancers.AssertNumberOfCalls(t, "PoolUpdate", 1)
+		},
+	}
+}
+
+func poolMembersUpdateCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	current := samplePoolWithMembers(
+		samplePoolMember("member-1", "10.0.0.1", 1),
+		samplePoolMember("member-2", "10.0.0.2", 1),
+	)
+	mc.Loadbalancers.On("PoolGet", mock.Anything, testPoolMembersPoolID).
+		Return(current, nil, nil).Once()
+
+	// member-1 changes its weight in place, member-2 is removed and 10.0.0.3 is added.
+	mc.Loadbalancers.On("PoolUpdate", mock.Anything, testPoolMembersPoolID,
+		mock.MatchedBy(func(req *edgecloud.PoolUpdateRequest) bool {
+			if len(req.Members) != 2 {
+				return false
+			}
+			byAddress := map[string]edgecloud.PoolMemberCreateRequest{}
+			for _, member := range req.Members {
+				byAddress[member.Address.String()] = member
+			}
+			return byAddress["10.0.0.1"].ID == "member-1" && byAddress["10.0.0.1"].Weight == 5 &&
+				byAddress["10.0.0.3"].ID == ""
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-members-update"}}, nil, nil).Once()
+	expectPoolMembersTask(mc, "task-members-update")
+
+	mc.Loadbalancers.On("PoolGet", mock.Anything, testPoolMembersPoolID).
+		Return(samplePoolWithMembers(
+			samplePoolMember("member-1", "10.0.0.1", 5),
+			samplePoolMember("member-3", "10.0.0.3", 1),
+		), nil, nil).Once()
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "add, remove and reweight in one update",
+		Op:           support.OpApply,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testPoolMembersPoolID,
+		CurrentState: poolMembersConfig(map[string]int{"10.0.0.1": 1, "10.0.0.2": 1}),
+		NewConfig:    poolMembersConfig(map[string]int{"10.0.0.1": 5, "10.0.0.3": 1}),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, mc *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateAttrs(t, state, map[string]string{"member.#": "2"})
+			mc.Loadbalancers.AssertNumberOfCalls(t, "PoolUpdate", 1)
+		},
+	}
+}
+
+func poolMembersReadOutsideMemberCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Loadbalancers.On("PoolGet", mock.Anything, testPoolMembersPoolID).
+		Return(samplePoolWithMembers(
+			samplePoolMember("member-1", "10.0.0.1", 1),
+			samplePoolMember("member-outside", "10.0.0.9", 1),
+		), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "read detects member added outside",
+		Op:           support.OpRead,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testPoolMembersPoolID,
+		CurrentState: poolMembersConfig(map[string]int{"10.0.0.1": 1}),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateAttrs(t, state, map[string]string{"member.#": "2"})
+		},
+	}
+}
+
+func poolMembersDeleteCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Loadbalancers.On("PoolGet", mock.Anything, testPoolMembersPoolID).
+		Return(samplePoolWithMembers(
+			samplePoolMember("member-1", "10.0.0.1", 1),
+			samplePoolMember("member-2", "10.0.0.2", 1),
+		), nil, nil)
+
+	mc.Loadbalancers.On("PoolUpdate", mock.Anything, testPoolMembersPoolID,
+		mock.MatchedBy(func(req *edgecloud.PoolUpdateRequest) bool {
+			return len(req.Members) == 1 && req.Members[0].ID == "member-1"
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-members-clear"}}, nil, nil).Once()
+	expectPoolMembersTask(mc, "task-members-clear")
+
+	mc.Loadbalancers.On("PoolMemberDelete", mock.Anything, testPoolMembersPoolID, "member-1").
+		Return(&edgecloud.TaskResponse{Tasks: []string{"task-members-delete"}}, nil, nil).Once()
+	expectPoolMembersTask(mc, "task-members-delete")
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "delete removes every member",
+		Op:           support.OpDelete,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testPoolMembersPoolID,
+		CurrentState: poolMembersConfig(map[string]int{"10.0.0.1": 1, "10.0.0.2": 1}),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be nil after delete")
+		},
+	}
+}
+
+func TestIntegrationLBPoolMembers_TableDriven(t *testing.T) {
+	t.Parallel()
+
+	resource := provider.Provider().ResourcesMap["edgecenter_lbpool_members"]
+
+	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
+		poolMembersCreateCase(),
+		poolMembersUpdateCase(),
+		poolMembersReadOutsideMemberCase(),
+		poolMembersDeleteCase(),
+	}
+
+	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
+}
@@ -48,6 +48,30 @@ func sampleLifecyclePolicy(id int, name string) *edgecloud.LifeCyclePolicy {
 	}
 }
 
+// sampleCronLifecyclePolicy returns a policy that snapshots its volume every night at 2 in Amsterdam.
+func sampleCronLifecyclePolicy(id int, name string) *edgecloud.LifeCyclePolicy {
+	policy := sampleLifecyclePolicy(id, name)
+	policy.Schedules = []edgecloud.LifeCyclePolicySchedule{
+		edgecloud.LifeCyclePolicyCronSchedule{
+			LifeCyclePolicyCommonSchedule: edgecloud.LifeCyclePolicyCommonSchedule{
+				Type:                 edgecloud.LifeCyclePolicyScheduleTypeCron,
+				ID:                   "sched-1",
+				MaxQuantity:          7,
+				ResourceNameTemplate: "snap-{volume_id}",
+			},
+			Timezone:  "Europe/Amsterdam",
+			Month:     "*",
+			Week:      "*",
+			Day:       "*",
+			DayOfWeek: "*",
+			Hour:      "2",
+			Minute:    "0",
+		},
+	}
+
+	return policy
+}
+
 func lifecyclePolicyCreateCase(lcpID int) support.ResourceCase[*cloudmock.MockedCloud] {
 	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
 	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
@@ -101,6 +125,185 @@ func lifecyclePolicyCreateCase(lcpID int) support.ResourceCase[*cloudmock.Mocked
 	}
 }
 
+const testLCPInstanceID = "3f1c9a52-7d4e-4b8a-9c61-0e2d5f7a8b93"
+
+func lifecyclePolicyCreateInstanceCase(lcpID int) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	// The volumes of the instance are resolved once at plan time and once at apply time.
+	mc.Instances.On("Get", mock.Anything, testLCPInstanceID).
+		Return(&edgecloud.Instance{ID: testLCPInstanceID, Name: "test-instance"}, nil, nil).Twice()
+	mc.Volumes.On("List", mock.Anything,
+		mock.MatchedBy(func(opts *edgecloud.VolumeListOptions) bool {
+			return opts.InstanceID == testLCPInstanceID
+		}),
+	).Return([]edgecloud.Volume{{ID: "vol-root"}, {ID: "vol-data"}}, nil, nil).Twice()
+
+	mc.LifeCyclePolicies.On("Create", mock.Anything,
+		mock.MatchedBy(func(req *edgecloud.LifeCyclePolicyCreateRequest) bool {
+			return len(req.VolumeIds) == 3 &&
+				req.VolumeIds[0] == "vol-1" && req.VolumeIds[1] == "vol-data" && req.VolumeIds[2] == "vol-root"
+		}),
+	).Return(sampleLifecyclePolicy(lcpID, "test-lcp"), nil, nil)
+
+	created := sampleLifecyclePolicy(lcpID, "test-lcp")
+	created.Volumes = []edgecloud.LifeCyclePolicyVolume{
+		{ID: "vol-1", Name: "test-vol"},
+		{ID: "vol-data", Name: "test-vol-data"},
+		{ID: "vol-root", Name: "test-vol-root"},
+	}
+	mc.LifeCyclePolicies.On("Get", mock.Anything, lcpID,
+		mock.MatchedBy(func(opts *edgecloud.LifeCyclePolicyGetOptions) bool {
+			return opts.NeedVolumes
+		}),
+	).Return(created, nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:    "create with the volumes of an instance",
+		Op:      support.OpApply,
+		Prepare: func() *cloudmock.MockedCloud { return mc },
+		NewConfig: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			cloud.WithName("test-lcp"),
+			map[string]interface{}{
+				"volume": []interface{}{
+					map[string]interface{}{
+						"id": "vol-1",
+					},
+				},
+				"instance_ids": []interface{}{testLCPInstanceID},
+				"schedule": []interface{}{
+					map[string]interface{}{
+						"max_quantity": 5,
+						"interval": []interface{}{
+							map[string]interface{}{
+								"hours": 1,
+							},
+						},
+					},
+				},
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, "1")
+			support.RequireStateAttrs(t, state, map[string]string{
+				"volume.#":              "1",
+				"instance_ids.#":        "1",
+				"instance_volume_ids.#": "2",
+			})
+		},
+	}
+}
+
+func lifecyclePolicyCreateCronCase(lcpID int) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	created := sampleCronLifecyclePolicy(lcpID, "test-lcp")
+
+	mc.LifeCyclePolicies.On("Create", mock.Anything,
+		mock.MatchedBy(func(req *edgecloud.LifeCyclePolicyCreateRequest) bool {
+			return req.Name == "test-lcp" && len(req.Schedules) == 1
+		}),
+	).Return(created, nil, nil)
+
+	mc.LifeCyclePolicies.On("Get", mock.Anything, lcpID,
+		mock.MatchedBy(func(opts *edgecloud.LifeCyclePolicyGetOptions) bool {
+			return opts.NeedVolumes
+		}),
+	).Return(created, nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:    "create with a cron schedule previews the next runs",
+		Op:      support.OpApply,
+		Prepare: func() *cloudmock.MockedCloud { return mc },
+		NewConfig: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			cloud.WithName("test-lcp"),
+			map[string]interface{}{
+				"volume": []interface{}{
+					map[string]interface{}{
+						"id": "vol-1",
+					},
+				},
+				"schedule": []interface{}{
+					map[string]interface{}{
+						"max_quantity": 7,
+						"cron": []interface{}{
+							map[string]interface{}{
+								"timezone": "Europe/Amsterdam",
+								"hour":     "2",
+							},
+						},
+						"retention_time": []interface{}{
+							map[string]interface{}{
+								"weeks": 1,
+							},
+						},
+					},
+				},
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, "1")
+			support.RequireStateAttrs(t, state, map[string]string{
+				"next_runs.#": "5",
+			})
+		},
+	}
+}
+
+func lifecyclePolicyReadRecomputesNextRunsCase(lcpID int) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.LifeCyclePolicies.On("Get", mock.Anything, lcpID,
+		mock.MatchedBy(func(opts *edgecloud.LifeCyclePolicyGetOptions) bool {
+			return opts.NeedVolumes
+		}),
+	).Return(sampleCronLifecyclePolicy(lcpID, "test-lcp"), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "read recomputes the next runs",
+		Op:        support.OpRead,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: fmt.Sprint(lcpID),
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			cloud.WithName("test-lcp"),
+			map[string]interface{}{
+				"volume": []interface{}{
+					map[string]interface{}{
+						"id": "vol-1",
+					},
+				},
+				"schedule": []interface{}{
+					map[string]interface{}{
+						"max_quantity": 7,
+						"cron": []interface{}{
+							map[string]interface{}{
+								"timezone": "Europe/Amsterdam",
+								"hour":     "2",
+							},
+						},
+					},
+				},
+				"next_runs": []interface{}{"2026-01-01T02:00:00+01:00"},
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"next_runs.#": "5",
+			})
+			require.NotEqual(t, "2026-01-01T02:00:00+01:00", state.Attributes["next_runs.0"])
+		},
+	}
+}
+
 func lifecyclePolicyUpdateNameCase(lcpID int) support.ResourceCase[*cloudmock.MockedCloud] {
 	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
 	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
@@ -416,6 +619,9 @@ func TestIntegrationLifecyclePolicy_TableDriven(t *testing.T) {
 
 	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
 		lifecyclePolicyCreateCase(testLCPID),
+		lifecyclePolicyCreateInstanceCase(testLCPID),
+		lifecyclePolicyCreateCronCase(testLCPID),
+		lifecyclePolicyReadRecomputesNextRunsCase(testLCPID),
 		lifecyclePolicyUpdateNameCase(testLCPID),
 		lifecyclePolicyUpdateVolumesCase(testLCPID),
 		lifecyclePolicyDeleteCase(testLCPID),
@@ -0,0 +1,327 @@
+//go:build integration
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud"
+	cloudmock "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud/mock"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/provider"
+)
+
+const (
+	testNetworkPortID   = "net-port-id"
+	testPortNetworkID   = "port-network-id"
+	testPortSubnetID    = "port-subnet-id"
+	testPortFixedIPAddr = "192.168.10.5"
+)
+
+func sampleNetworkPort(id, instanceID string) *edgecloud.ReservedFixedIP {
+	port := &edgecloud.ReservedFixedIP{
+		PortID:         id,
+		FixedIPAddress: net.ParseIP(testPortFixedIPAddr),
+		Status:         "ACTIVE",
+		ProjectID:      testProjectID,
+		RegionID:       testRegionID,
+		SubnetID:       testPortSubnetID,
+		NetworkID:      testPortNetworkID,
+		Reservation: edgecloud.Reservation{
+			Status: "available",
+		},
+	}
+	if instanceID != "" {
+		port.Reservation = edgecloud.Reservation{
+			Status:       "attached",
+			ResourceType: "instance",
+			ResourceID:   instanceID,
+		}
+	}
+
+	return port
+}
+
+func portCreateInSubnetCase(portID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.ReservedFixedIP.On("Create", mock.Anything,
+		mock.MatchedBy(func(req *edgecloud.ReservedFixedIPCreateRequest) bool {
+			return req.Type == edgecloud.ReservedFixedIPTypeSubnet && req.SubnetID == testPortSubnetID
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-port-1"}}, nil, nil)
+
+	mc.Tasks.On("Get", mock.Anything, "task-port-1").
+		Return(&edgecloud.Task{
+			State: edgecloud.TaskStateFinished,
+			CreatedResources: map[string]interface{}{
+				"ports": []interface{}{portID},
+			},
+		}, nil, nil)
+
+	mc.ReservedFixedIP.On("Get", mock.Anything, portID).
+		Return(sampleNetworkPort(portID, ""), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:    "successful create in subnet",
+		Op:      support.OpApply,
+		Prepare: func() *cloudmock.MockedCloud { return mc },
+		NewConfig: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"subnet_id": testPortSubnetID,
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, portID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"network_id":       testPortNetworkID,
+				"subnet_id":        testPortSubnetID,
+				"fixed_ip_address": testPortFixedIPAddr,
+				"status":           "ACTIVE",
+				"instance_id":      "",
+			})
+		},
+	}
+}
+
+func portCreateWithAddressPairsCase(portID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.ReservedFixedIP.On("Create", mock.Anything,
+		mock.MatchedBy(func(req *edgecloud.ReservedFixedIPCreateRequest) bool {
+			return req.Type == edgecloud.ReservedFixedIPTypeIPAddress &&
+				req.NetworkID == testPortNetworkID && req.IPAddress == testPortFixedIPAddr
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-port-2"}}, nil, nil)
+
+	mc.Tasks.On("Get", mock.Anything, "task-port-2").
+		Return(&edgecloud.Task{
+			State: edgecloud.TaskStateFinished,
+			CreatedResources: map[string]interface{}{
+				"ports": []interface{}{portID},
+			},
+		}, nil, nil)
+
+	mc.Ports.On("Assign", mock.Anything, portID,
+		mock.MatchedBy(func(req *edgecloud.PortsAllowedAddressPairsRequest) bool {
+			return len(req.AllowedAddressPairs) == 1 && req.AllowedAddressPairs[0].IPAddress == "192.168.10.100"
+		}),
+	).Return((*edgecloud.Port)(nil), nil, nil).Once()
+
+	mc.ReservedFixedIP.On("Get", mock.Anything, portID).
+		Return(sampleNetworkPort(portID, ""), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:    "create with fixed ip address, allowed address pairs and security groups",
+		Op:      support.OpApply,
+		Prepare: func() *cloudmock.MockedCloud { return mc },
+		NewConfig: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"network_id":       testPortNetworkID,
+				"fixed_ip_address": testPortFixedIPAddr,
+				"allowed_address_pairs": []interface{}{
+					map[string]interface{}{"ip_address": "192.168.10.100"},
+				},
+				"security_group_ids": []interface{}{testSGID1},
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Len(t, diags, 1, "a detached port must warn about its security groups")
+			require.Equal(t, diag.Warning, diags[0].Severity)
+			support.RequireStateID(t, state, portID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"fixed_ip_address":     testPortFixedIPAddr,
+				"security_group_ids.#": "1",
+			})
+		},
+	}
+}
+
+func portReadAttachedCase(portID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.ReservedFixedIP.On("Get", mock.Anything, portID).
+		Return(sampleNetworkPort(portID, testInstanceID), nil, nil)
+
+	mc.Instances.On("InterfaceList", mock.Anything, testInstanceID).
+		Return([]edgecloud.InstancePortInterface{
+			sampleInstanceIface(portID, true),
+		}, nil, nil).Once()
+
+	mc.Instances.On("PortsList", mock.Anything, testInstanceID).
+		Return([]edgecloud.InstancePort{
+			samplePort(portID, testSGID1, testSGID2),
+		}, nil, nil).Once()
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "read attached port reads security groups from the instance",
+		Op:        support.OpRead,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: portID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"subnet_id": testPortSubnetID,
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, portID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"instance_id":            testInstanceID,
+				"port_security_disabled": "false",
+				"security_group_ids.#":   "2",
+			})
+		},
+	}
+}
+
+func portUpdateSecurityGroupsCase(portID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.ReservedFixedIP.On("Get", mock.Anything, portID).
+		Return(sampleNetworkPort(portID, testInstanceID), nil, nil)
+
+	mc.SecurityGroups.On("List", mock.Anything, mock.Anything).
+		Return([]edgecloud.SecurityGroup{
+			{ID: testSGID1, Name: testSGID1},
+			{ID: testSGID2, Name: testSGID2},
+		}, nil, nil).Once()
+
+	mc.Instances.On("SecurityGroupAssign", mock.Anything, testInstanceID,
+		mock.MatchedBy(func(req *edgecloud.AssignSecurityGroupRequest) bool {
+			return len(req.PortsSecurityGroupNames) == 1 &&
+				req.PortsSecurityGroupNames[0].PortID == portID &&
+				len(req.PortsSecurityGroupNames[0].SecurityGroupNames) == 1 &&
+				req.PortsSecurityGroupNames[0].SecurityGroupNames[0] == testSGID2
+		}),
+	).Return(nil, nil).Once()
+
+	mc.Instances.On("InterfaceList", mock.Anything, testInstanceID).
+		Return([]edgecloud.InstancePortInterface{
+			sampleInstanceIface(portID, true),
+		}, nil, nil).Once()
+
+	mc.Instances.On("PortsList", mock.Anything, testInstanceID).
+		Return([]edgecloud.InstancePort{
+			samplePort(portID, testSGID1, testSGID2),
+		}, nil, nil).Once()
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "add security group to attached port",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: portID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"subnet_id":          testPortSubnetID,
+				"security_group_ids": []interface{}{testSGID1},
+			},
+		),
+		NewConfig: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"subnet_id":          testPortSubnetID,
+				"security_group_ids": []interface{}{testSGID1, testSGID2},
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, portID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"security_group_ids.#": "2",
+			})
+		},
+	}
+}
+
+func portReadNonExistentCase(portID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.ReservedFixedIP.On("Get", mock.Anything, portID).
+		Return(nil, &edgecloud.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, fmt.Errorf("not found"))
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "read non-existent (404)",
+		Op:        support.OpRead,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: portID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"subnet_id": testPortSubnetID,
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			require.Nil(t, state, "state must be nil when resource not found")
+		},
+	}
+}
+
+func portDeleteCase(portID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.ReservedFixedIP.On("Delete", mock.Anything, portID).
+		Return(&edgecloud.TaskResponse{Tasks: []string{"task-port-del"}}, nil, nil)
+
+	mc.Tasks.On("Get", mock.Anything, "task-port-del").
+		Return(&edgecloud.Task{
+			State: edgecloud.TaskStateFinished,
+		}, nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "delete port",
+		Op:        support.OpDelete,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: portID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			map[string]interface{}{
+				"subnet_id": testPortSubnetID,
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be nil after delete")
+		},
+	}
+}
+
+func TestIntegrationPort_TableDriven(t *testing.T) {
+	t.Parallel()
+
+	resource := provider.Provider().ResourcesMap["edgecenter_port"]
+
+	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
+		portCreateInSubnetCase(testNetworkPortID),
+		portCreateWithAddressPairsCase(testNetworkPortID),
+		portReadAttachedCase(testNetworkPortID),
+		portUpdateSecurityGroupsCase(testNetworkPortID),
+		portReadNonExistentCase(testNetworkPortID),
+		portDeleteCase(testNetworkPortID),
+	}
+
+	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
+}
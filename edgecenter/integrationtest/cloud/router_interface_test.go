@@ -0,0 +1,159 @@
+//go:build integration
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud"
+	cloudmock "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud/mock"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/provider"
+)
+
+const testRouterSubnetID = "subnet-1"
+
+func routerWithSubnet(routerID, subnetID string) *edgecloud.Router {
+	router := sampleRouter(routerID, "test-router")
+	router.Interfaces = []edgecloud.RouterInterface{
+		{
+			PortID:     "port-1",
+			NetworkID:  "net-1",
+			MacAddress: "aa:bb:cc:dd:ee:ff",
+			IPAssignments: []edgecloud.PortIP{
+				{SubnetID: subnetID, IPAddress: net.ParseIP("192.168.10.1")},
+			},
+		},
+	}
+
+	return router
+}
+
+func routerInterfaceConfig(routerID, subnetID string) map[string]interface{} {
+	return cloud.Merge(
+		cloud.WithProjectRegion(testProjectID, testRegionID),
+		map[string]interface{}{
+			"router_id": routerID,
+			"subnet_id": subnetID,
+		},
+	)
+}
+
+func routerInterfaceCreateCase(routerID, subnetID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.Routers.On("Attach", mock.Anything, routerID,
+		mock.MatchedBy(func(req *edgecloud.RouterAttachRequest) bool {
+			return req.SubnetID == subnetID
+		}),
+	).Return((*edgecloud.Router)(nil), nil, nil)
+
+	mc.Routers.On("Get", mock.Anything, routerID).
+		Return(routerWithSubnet(routerID, subnetID), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "successful create",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		NewConfig: routerInterfaceConfig(routerID, subnetID),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, routerID+":"+subnetID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"port_id":     "port-1",
+				"network_id":  "net-1",
+				"mac_address": "aa:bb:cc:dd:ee:ff",
+				"ip_address":  "192.168.10.1",
+			})
+		},
+	}
+}
+
+func routerInterfaceReadDetachedCase(routerID, subnetID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Routers.On("Get", mock.Anything, routerID).
+		Return(sampleRouter(routerID, "test-router"), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "read detached interface",
+		Op:           support.OpRead,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    routerID + ":" + subnetID,
+		CurrentState: routerInterfaceConfig(routerID, subnetID),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			require.Nil(t, state, "state must be nil when subnet is not attached")
+		},
+	}
+}
+
+func routerInterfaceDeleteCase(routerID, subnetID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Routers.On("Detach", mock.Anything, routerID,
+		mock.MatchedBy(func(req *edgecloud.RouterDetachRequest) bool {
+			return req.SubnetID == subnetID
+		}),
+	).Return((*edgecloud.Router)(nil), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "delete detaches subnet",
+		Op:           support.OpDelete,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    routerID + ":" + subnetID,
+		CurrentState: routerInterfaceConfig(routerID, subnetID),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be nil after delete")
+		},
+	}
+}
+
+func routerInterfaceDeleteDetachedCase(routerID, subnetID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Routers.On("Detach", mock.Anything, routerID, mock.Anything).
+		Return(nil, &edgecloud.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, fmt.Errorf("not found"))
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "delete already detached interface",
+		Op:           support.OpDelete,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    routerID + ":" + subnetID,
+		CurrentState: routerInterfaceConfig(routerID, subnetID),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be nil after delete")
+		},
+	}
+}
+
+func TestIntegrationRouterInterface_TableDriven(t *testing.T) {
+	t.Parallel()
+
+	resource := provider.Provider().ResourcesMap["edgecenter_router_interface"]
+
+	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
+		routerInterfaceCreateCase(testRouterID, testRouterSubnetID),
+		routerInterfaceReadDetachedCase(testRouterID, testRouterSubnetID),
+		routerInterfaceDeleteCase(testRouterID, testRouterSubnetID),
+		routerInterfaceDeleteDetachedCase(testRouterID, testRouterSubnetID),
+	}
+
+	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
+}
@@ -0,0 +1,177 @@
+//go:build integration
+
+package edgecenter_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud"
+	cloudmock "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud/mock"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/provider"
+)
+
+func hostRoute(destination, nexthop string) edgecloud.HostRoute {
+	_, ipNet, _ := net.ParseCIDR(destination)
+	var cidr edgecloud.CIDR
+	cidr.IP = ipNet.IP
+	cidr.Mask = ipNet.Mask
+
+	return edgecloud.HostRoute{Destination: cidr, NextHop: net.ParseIP(nexthop)}
+}
+
+func routerWithRoutes(routerID string, routes ...edgecloud.HostRoute) *edgecloud.Router {
+	router := sampleRouter(routerID, "test-router")
+	router.Routes = routes
+
+	return router
+}
+
+func routeDestinations(routes []edgecloud.HostRoute) []string {
+	destinations := make([]string, len(routes))
+	for i, r := range routes {
+		destinations[i] = r.Destination.String()
+	}
+
+	return destinations
+}
+
+func routerRouteConfig(routerID, destination, nexthop string) map[string]interface{} {
+	return cloud.Merge(
+		cloud.WithProjectRegion(testProjectID, testRegionID),
+		map[string]interface{}{
+			"router_id":   routerID,
+			"destination": destination,
+			"nexthop":     nexthop,
+		},
+	)
+}
+
+func routerRouteCreateCase(routerID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	existing := hostRoute("10.0.1.0/24", "192.168.10.11")
+	added := hostRoute("10.0.2.0/24", "192.168.10.12")
+
+	mc.Routers.On("Get", mock.Anything, routerID).
+		Return(routerWithRoutes(routerID, existing), nil, nil).Once()
+
+	mc.Routers.On("Update", mock.Anything, routerID,
+		mock.MatchedBy(func(req *edgecloud.RouterUpdateRequest) bool {
+			return req.Name == "test-router" &&
+				len(req.Routes) == 2 &&
+				req.Routes[0].Destination.String() == "10.0.1.0/24" &&
+				req.Routes[1].Destination.String() == "10.0.2.0/24"
+		}),
+	).Return((*edgecloud.Router)(nil), nil, nil)
+
+	mc.Routers.On("Get", mock.Anything, routerID).
+		Return(routerWithRoutes(routerID, existing, added), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "create keeps the other routes",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		NewConfig: routerRouteConfig(routerID, "10.0.2.0/24", "192.168.10.12"),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, routerID+":10.0.2.0/24")
+			support.RequireStateAttrs(t, state, map[string]string{
+				"nexthop": "192.168.10.12",
+			})
+		},
+	}
+}
+
+func routerRouteCreateDuplicateCase(routerID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Routers.On("Get", mock.Anything, routerID).
+		Return(routerWithRoutes(routerID, hostRoute("10.0.2.0/24", "192.168.10.11")), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "create route to an existing destination fails",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		NewConfig: routerRouteConfig(routerID, "10.0.2.0/24", "192.168.10.12"),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireErrorDiagContains(t, diags, "already has a route to 10.0.2.0/24")
+			require.Nil(t, state, "state must be nil when create fails")
+		},
+	}
+}
+
+func routerRouteReadChangedNexthopCase(routerID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Routers.On("Get", mock.Anything, routerID).
+		Return(routerWithRoutes(routerID, hostRoute("10.0.2.0/24", "192.168.10.99")), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "read route with another nexthop",
+		Op:           support.OpRead,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    routerID + ":10.0.2.0/24",
+		CurrentState: routerRouteConfig(routerID, "10.0.2.0/24", "192.168.10.12"),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			require.Nil(t, state, "state must be nil when the route is gone")
+		},
+	}
+}
+
+func routerRouteDeleteCase(routerID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Routers.On("Get", mock.Anything, routerID).
+		Return(routerWithRoutes(routerID,
+			hostRoute("10.0.1.0/24", "192.168.10.11"),
+			hostRoute("10.0.2.0/24", "192.168.10.12"),
+		), nil, nil)
+
+	mc.Routers.On("Update", mock.Anything, routerID,
+		mock.MatchedBy(func(req *edgecloud.RouterUpdateRequest) bool {
+			destinations := routeDestinations(req.Routes)
+			return len(destinations) == 1 && destinations[0] == "10.0.1.0/24"
+		}),
+	).Return((*edgecloud.Router)(nil), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "delete keeps the other routes",
+		Op:           support.OpDelete,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    routerID + ":10.0.2.0/24",
+		CurrentState: routerRouteConfig(routerID, "10.0.2.0/24", "192.168.10.12"),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be nil after delete")
+		},
+	}
+}
+
+func TestIntegrationRouterRoute_TableDriven(t *testing.T) {
+	t.Parallel()
+
+	resource := provider.Provider().ResourcesMap["edgecenter_router_route"]
+
+	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
+		routerRouteCreateCase(testRouterID),
+		routerRouteCreateDuplicateCase(testRouterID),
+		routerRouteReadChangedNexthopCase(testRouterID),
+		routerRouteDeleteCase(testRouterID),
+	}
+
+	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
+}
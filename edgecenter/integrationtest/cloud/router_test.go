@@ -357,6 +357,55 @@ func routerUpdateExternalGatewayInfoCase(routerID string) support.ResourceCase[*
 	}
 }
 
+func routerNonExclusiveUpdateNameCase(routerID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	// The route and the interface are managed by edgecenter_router_route and edgecenter_router_interface.
+	router := routerWithSubnet(routerID, testRouterSubnetID)
+	router.Routes = []edgecloud.HostRoute{hostRoute("10.0.2.0/24", "192.168.10.12")}
+
+	mc.Routers.On("Get", mock.Anything, routerID).
+		Return(router, nil, nil)
+
+	mc.Routers.On("Update", mock.Anything, routerID,
+		mock.MatchedBy(func(req *edgecloud.RouterUpdateRequest) bool {
+			destinations := routeDestinations(req.Routes)
+			return req.Name == "updated-router" && len(destinations) == 1 && destinations[0] == "10.0.2.0/24"
+		}),
+	).Return((*edgecloud.Router)(nil), nil, nil)
+
+	nonExclusive := map[string]interface{}{
+		"non_exclusive_interfaces": true,
+		"non_exclusive_routes":     true,
+	}
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "update name of non-exclusive router keeps the routes",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: routerID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			cloud.WithName("test-router"),
+			nonExclusive,
+		),
+		NewConfig: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			cloud.WithName("updated-router"),
+			nonExclusive,
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, routerID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"interfaces.#": "0",
+				"routes.#":     "0",
+			})
+		},
+	}
+}
+
 func TestIntegrationRouter_TableDriven(t *testing.T) {
 	t.Parallel()
 
@@ -372,6 +421,7 @@ func TestIntegrationRouter_TableDriven(t *testing.T) {
 		routerDeleteTaskErrorCase(testRouterID),
 		routerReadNonExistentCase(testRouterID),
 		routerUpdateNameCase(testRouterID),
+		routerNonExclusiveUpdateNameCase(testRouterID),
 	}
 
 	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
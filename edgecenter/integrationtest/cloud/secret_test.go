@@ -21,8 +21,9 @@ import (
 )
 
 const (
-	testSecretID   = "secret-1"
-	testSecretName = "test-cert"
+	testSecretID        = "secret-1"
+	testSecretName      = "test-cert"
+	testRotatedSecretID = "secret-2"
 )
 
 func sampleSecret(id, name, algorithm, status string) *edgecloud.Secret {
@@ -81,6 +82,7 @@ func secretCreateCase(secretID string) support.ResourceCase[*cloudmock.MockedClo
 			support.RequireNoDiags(t, diags)
 			support.RequireStateID(t, state, secretID)
 			support.RequireStateAttrs(t, state, map[string]string{
+				"secret_id": secretID,
 				"name":      "test-cert",
 				"algorithm": "RSA",
 				"status":    "ACTIVE",
@@ -223,6 +225,139 @@ func secretDeleteTaskErrorCase(secretID string) support.ResourceCase[*cloudmock.
 	}
 }
 
+func secretConfig(certificate string) map[string]interface{} {
+	return cloud.Merge(
+		cloud.WithProjectRegion(testProjectID, testRegionID),
+		cloud.WithName("test-cert"),
+		map[string]interface{}{
+			"private_key":       "priv-key-pem",
+			"certificate":       certificate,
+			"certificate_chain": "chain-pem",
+		},
+	)
+}
+
+func expectSecretRotationCreate(mc *cloudmock.MockedCloud, newID string) {
+	mc.Secrets.On("CreateV2", mock.Anything,
+		mock.MatchedBy(func(req *edgecloud.SecretCreateRequestV2) bool {
+			return req.Name == "test-cert" && req.Payload.Certificate == "cert-pem-2"
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-rot-secret"}}, nil, nil)
+
+	mc.Tasks.On("Get", mock.Anything, "task-rot-secret").
+		Return(&edgecloud.Task{
+			State: edgecloud.TaskStateFinished,
+			CreatedResources: map[string]interface{}{
+				"secrets": []interface{}{newID},
+			},
+		}, nil, nil)
+
+	mc.Loadbalancers.On("ListenerList", mock.Anything, mock.Anything).
+		Return([]edgecloud.Listener{
+			{ID: "listener-https", Name: "https", SecretID: testSecretID},
+			{ID: "listener-sni", Name: "sni", SecretID: "secret-other", SNISecretID: []string{testSecretID}},
+			{ID: "listener-http", Name: "http"},
+		}, nil, nil)
+}
+
+func secretRotateCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	expectSecretRotationCreate(mc, testRotatedSecretID)
+
+	mc.Loadbalancers.On("ListenerUpdate", mock.Anything, "listener-https",
+		mock.MatchedBy(func(req *edgecloud.ListenerUpdateRequest) bool {
+			return req.Name == "https" && req.SecretID == testRotatedSecretID && len(req.SNISecretID) == 0
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-listener-https"}}, nil, nil)
+	mc.Tasks.On("Get", mock.Anything, "task-listener-https").
+		Return(&edgecloud.Task{State: edgecloud.TaskStateFinished}, nil, nil)
+
+	mc.Loadbalancers.On("ListenerUpdate", mock.Anything, "listener-sni",
+		mock.MatchedBy(func(req *edgecloud.ListenerUpdateRequest) bool {
+			return req.SecretID == "" && len(req.SNISecretID) == 1 && req.SNISecretID[0] == testRotatedSecretID
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-listener-sni"}}, nil, nil)
+	mc.Tasks.On("Get", mock.Anything, "task-listener-sni").
+		Return(&edgecloud.Task{State: edgecloud.TaskStateFinished}, nil, nil)
+
+	mc.Secrets.On("Delete", mock.Anything, testSecretID).
+		Return(&edgecloud.TaskResponse{Tasks: []string{"task-del-secret"}}, nil, nil)
+	mc.Tasks.On("Get", mock.Anything, "task-del-secret").
+		Return(&edgecloud.Task{State: edgecloud.TaskStateFinished}, nil, nil)
+
+	mc.Secrets.On("Get", mock.Anything, testRotatedSecretID).
+		Return(sampleSecret(testRotatedSecretID, "test-cert", "RSA", "ACTIVE"), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "rotate certificate",
+		Op:           support.OpApply,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testSecretID,
+		CurrentState: secretConfig("cert-pem"),
+		NewConfig:    secretConfig("cert-pem-2"),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, mc *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, testRotatedSecretID)
+			support.RequireStateAttrs(t, state, map[string]string{"secret_id": testRotatedSecretID})
+			mc.Loadbalancers.AssertNumberOfCalls(t, "ListenerUpdate", 2)
+			mc.Secrets.AssertCalled(t, "Delete", mock.Anything, testSecretID)
+		},
+	}
+}
+
+func secretRotateRollbackCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	expectSecretRotationCreate(mc, testRotatedSecretID)
+
+	mc.Loadbalancers.On("ListenerUpdate", mock.Anything, "listener-https",
+		mock.MatchedBy(func(req *edgecloud.ListenerUpdateRequest) bool {
+			return req.SecretID == testRotatedSecretID
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-listener-https"}}, nil, nil)
+	mc.Tasks.On("Get", mock.Anything, "task-listener-https").
+		Return(&edgecloud.Task{State: edgecloud.TaskStateFinished}, nil, nil)
+
+	mc.Loadbalancers.On("ListenerUpdate", mock.Anything, "listener-sni", mock.Anything).
+		Return(nil, nil, fmt.Errorf("listener is immutable"))
+
+	// the moved listener goes back to the old secret and the new secret is deleted
+	mc.Loadbalancers.On("ListenerGet", mock.Anything, "listener-https").
+		Return(&edgecloud.Listener{ID: "listener-https", Name: "https", SecretID: testRotatedSecretID}, nil, nil)
+	mc.Loadbalancers.On("ListenerUpdate", mock.Anything, "listener-https",
+		mock.MatchedBy(func(req *edgecloud.ListenerUpdateRequest) bool {
+			return req.SecretID == testSecretID
+		}),
+	).Return(&edgecloud.TaskResponse{Tasks: []string{"task-listener-back"}}, nil, nil)
+	mc.Tasks.On("Get", mock.Anything, "task-listener-back").
+		Return(&edgecloud.Task{State: edgecloud.TaskStateFinished}, nil, nil)
+
+	mc.Secrets.On("Delete", mock.Anything, testRotatedSecretID).
+		Return(&edgecloud.TaskResponse{Tasks: []string{"task-del-new-secret"}}, nil, nil)
+	mc.Tasks.On("Get", mock.Anything, "task-del-new-secret").
+		Return(&edgecloud.Task{State: edgecloud.TaskStateFinished}, nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "rotation rolls back when a listener cannot be moved",
+		Op:           support.OpApply,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testSecretID,
+		CurrentState: secretConfig("cert-pem"),
+		NewConfig:    secretConfig("cert-pem-2"),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, mc *cloudmock.MockedCloud) {
+			support.RequireHasErrorDiags(t, diags)
+			support.RequireErrorDiagContains(t, diags, "listener is immutable")
+			require.NotNil(t, state, "state is preserved when the rotation fails")
+			require.Equal(t, testSecretID, state.ID, "ID must not change when the rotation fails")
+			mc.Secrets.AssertCalled(t, "Delete", mock.Anything, testRotatedSecretID)
+			mc.Secrets.AssertNotCalled(t, "Delete", mock.Anything, testSecretID)
+		},
+	}
+}
+
 func TestIntegrationSecret_TableDriven(t *testing.T) {
 	t.Parallel()
 
@@ -235,6 +370,8 @@ func TestIntegrationSecret_TableDriven(t *testing.T) {
 		secretDeleteCase(testSecretID),
 		secretCreateAPIFailureCase(),
 		secretDeleteTaskErrorCase(testSecretID),
+		secretRotateCase(),
+		secretRotateRollbackCase(),
 	}
 
 	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
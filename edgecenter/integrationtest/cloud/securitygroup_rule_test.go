@@ -0,0 +1,167 @@
+//go:build integration
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud"
+	cloudmock "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud/mock"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/provider"
+)
+
+const (
+	testSecurityGroupRuleID = "rule-ssh"
+	testRemoteGroupID       = "sg-bastion"
+)
+
+func sampleSecurityGroupWithRemoteGroupRule(sgID string) *edgecloud.SecurityGroup {
+	sg := sampleSecurityGroup(sgID, "test-sg")
+	sg.SecurityGroupRules = append(sg.SecurityGroupRules, edgecloud.SecurityGroupRule{
+		ID:            testSecurityGroupRuleID,
+		Direction:     edgecloud.SGRuleDirectionIngress,
+		Protocol:      &tcpProto,
+		EtherType:     &ipv4,
+		PortRangeMax:  intPtr(22),
+		PortRangeMin:  intPtr(22),
+		Description:   strPtr("SSH from bastion"),
+		RemoteGroupID: strPtr(testRemoteGroupID),
+	})
+
+	return sg
+}
+
+func securityGroupRuleConfig() map[string]interface{} {
+	return cloud.Merge(
+		cloud.WithProjectRegion(testProjectID, testRegionID),
+		map[string]interface{}{
+			"security_group_id": testSecurityGroupID,
+			"direction":         "ingress",
+			"ethertype":         "IPv4",
+			"protocol":          "tcp",
+			"port_range_min":    22,
+			"port_range_max":    22,
+			"description":       "SSH from bastion",
+			"remote_group_id":   testRemoteGroupID,
+		},
+	)
+}
+
+func securityGroupRuleCreateWithRemoteGroupCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.SecurityGroups.On("RuleCreate", mock.Anything, testSecurityGroupID,
+		mock.MatchedBy(func(req *edgecloud.RuleCreateRequest) bool {
+			return req.Direction == edgecloud.SGRuleDirectionIngress &&
+				req.RemoteGroupID != nil && *req.RemoteGroupID == testRemoteGroupID &&
+				req.RemoteIPPrefix == nil &&
+				req.PortRangeMin != nil && *req.PortRangeMin == 22
+		}),
+	).Return(&edgecloud.SecurityGroupRule{ID: testSecurityGroupRuleID}, nil, nil)
+
+	mc.SecurityGroups.On("Get", mock.Anything, testSecurityGroupID).
+		Return(sampleSecurityGroupWithRemoteGroupRule(testSecurityGroupID), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "create with remote group",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		NewConfig: securityGroupRuleConfig(),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, testSecurityGroupRuleID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"security_group_id": testSecurityGroupID,
+				"remote_group_id":   testRemoteGroupID,
+				"remote_ip_prefix":  "",
+				"port_range_min":    "22",
+			})
+		},
+	}
+}
+
+func securityGroupRuleReadRemovedCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.SecurityGroups.On("Get", mock.Anything, testSecurityGroupID).
+		Return(sampleSecurityGroup(testSecurityGroupID, "test-sg"), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "read rule removed outside terraform",
+		Op:           support.OpRead,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testSecurityGroupRuleID,
+		CurrentState: securityGroupRuleConfig(),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be cleared when the rule is gone")
+		},
+	}
+}
+
+func securityGroupRuleReadGroupRemovedCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.SecurityGroups.On("Get", mock.Anything, testSecurityGroupID).
+		Return(nil, &edgecloud.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, fmt.Errorf("not found"))
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "read security group removed outside terraform",
+		Op:           support.OpRead,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testSecurityGroupRuleID,
+		CurrentState: securityGroupRuleConfig(),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be cleared when the security group is gone")
+		},
+	}
+}
+
+func securityGroupRuleDeleteCase() support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.SecurityGroups.On("RuleDelete", mock.Anything, testSecurityGroupRuleID).
+		Return(&edgecloud.TaskResponse{}, &edgecloud.Response{Response: &http.Response{StatusCode: http.StatusNoContent}}, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "delete rule",
+		Op:           support.OpDelete,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testSecurityGroupRuleID,
+		CurrentState: securityGroupRuleConfig(),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be nil after delete")
+		},
+	}
+}
+
+func TestIntegrationSecurityGroupRule_TableDriven(t *testing.T) {
+	t.Parallel()
+
+	resource := provider.Provider().ResourcesMap["edgecenter_securitygroup_rule"]
+
+	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
+		securityGroupRuleCreateWithRemoteGroupCase(),
+		securityGroupRuleReadRemovedCase(),
+		securityGroupRuleReadGroupRemovedCase(),
+		securityGroupRuleDeleteCase(),
+	}
+
+	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
+}
@@ -510,6 +510,43 @@ func securityGroupMetadataUpdateCase(sgID string) support.ResourceCase[*cloudmoc
 	}
 }
 
+func securityGroupReadNonExclusiveRulesCase(sgID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.SecurityGroups.On("Get", mock.Anything, sgID).
+		Return(sampleSecurityGroup(sgID, "test-sg"), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "read non-exclusive rules",
+		Op:        support.OpRead,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		CurrentID: sgID,
+		CurrentState: cloud.Merge(
+			cloud.WithProjectRegion(testProjectID, testRegionID),
+			cloud.WithName("test-sg"),
+			map[string]interface{}{
+				"non_exclusive_rules": true,
+				"security_group_rules": []interface{}{
+					map[string]interface{}{
+						"direction":   "egress",
+						"ethertype":   "IPv4",
+						"protocol":    "any",
+						"id":          "rule-egress",
+						"description": "",
+					},
+				},
+			},
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"security_group_rules.#": "1",
+			})
+		},
+	}
+}
+
 func TestIntegrationSecurityGroup_TableDriven(t *testing.T) {
 	t.Parallel()
 
@@ -524,6 +561,7 @@ func TestIntegrationSecurityGroup_TableDriven(t *testing.T) {
 		securityGroupValidationNoEgressCase(),
 		securityGroupReadNonExistentCase(testSecurityGroupID),
 		securityGroupDeleteAPIFailureCase(testSecurityGroupID),
+		securityGroupReadNonExclusiveRulesCase(testSecurityGroupID),
 	}
 
 	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
@@ -0,0 +1,128 @@
+//go:build integration
+
+package edgecenter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud"
+	cloudmock "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/integrationtest/support/cloud/mock"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/provider"
+)
+
+func sampleAttachedVolume(volID, instanceID string) *edgecloud.Volume {
+	vol := sampleVolume(volID, "data", 10)
+	vol.Attachments = []edgecloud.Attachment{
+		{ServerID: instanceID, VolumeID: volID, Device: "/dev/vdb"},
+	}
+
+	return vol
+}
+
+func volumeAttachmentConfig(volID string) map[string]interface{} {
+	return cloud.Merge(
+		cloud.WithProjectRegion(testProjectID, testRegionID),
+		map[string]interface{}{
+			"instance_id":    testInstanceID,
+			"volume_id":      volID,
+			"attachment_tag": "data",
+		},
+	)
+}
+
+func volumeAttachmentCreateCase(volID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+
+	mc.Volumes.On("Attach", mock.Anything, volID,
+		mock.MatchedBy(func(req *edgecloud.VolumeAttachRequest) bool {
+			return req.InstanceID == testInstanceID && req.AttachmentTag == "data"
+		}),
+	).Return(sampleAttachedVolume(volID, testInstanceID), nil, nil)
+
+	mc.Volumes.On("Get", mock.Anything, volID).
+		Return(sampleAttachedVolume(volID, testInstanceID), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:      "attach volume",
+		Op:        support.OpApply,
+		Prepare:   func() *cloudmock.MockedCloud { return mc },
+		NewConfig: volumeAttachmentConfig(volID),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, testInstanceID+":"+volID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"device":         "/dev/vdb",
+				"attachment_tag": "data",
+			})
+		},
+	}
+}
+
+func volumeAttachmentReadDetachedCase(volID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Volumes.On("Get", mock.Anything, volID).
+		Return(sampleVolume(volID, "data", 10), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "read volume detached outside terraform",
+		Op:           support.OpRead,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testInstanceID + ":" + volID,
+		CurrentState: volumeAttachmentConfig(volID),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be cleared when the volume is not attached")
+		},
+	}
+}
+
+func volumeAttachmentDeleteCase(volID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
+
+	mc.Volumes.On("Detach", mock.Anything, volID,
+		mock.MatchedBy(func(req *edgecloud.VolumeDetachRequest) bool {
+			return req.InstanceID == testInstanceID
+		}),
+	).Return(sampleVolume(volID, "data", 10), nil, nil)
+
+	mc.Volumes.On("Get", mock.Anything, volID).
+		Return(sampleVolume(volID, "data", 10), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:         "detach volume",
+		Op:           support.OpDelete,
+		Prepare:      func() *cloudmock.MockedCloud { return mc },
+		CurrentID:    testInstanceID + ":" + volID,
+		CurrentState: volumeAttachmentConfig(volID),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoErrorDiags(t, diags)
+			require.Nil(t, state, "state must be nil after detach")
+		},
+	}
+}
+
+func TestIntegrationVolumeAttachment_TableDriven(t *testing.T) {
+	t.Parallel()
+
+	resource := provider.Provider().ResourcesMap["edgecenter_volume_attachment"]
+
+	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
+		volumeAttachmentCreateCase(testVolumeID),
+		volumeAttachmentReadDetachedCase(testVolumeID),
+		volumeAttachmentDeleteCase(testVolumeID),
+	}
+
+	support.RunResourceCases(t, resource, cases, support.DispatchCase[*cloudmock.MockedCloud])
+}
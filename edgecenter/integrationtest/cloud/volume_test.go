@@ -77,6 +77,48 @@ func volumeCreateCase(volID string) support.ResourceCase[*cloudmock.MockedCloud]
 	}
 }
 
+func volumeCreateWithProviderDefaultsCase(volID string) support.ResourceCase[*cloudmock.MockedCloud] {
+	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
+	mc.Config.DefaultProjectID = testProjectID
+	mc.Config.DefaultRegionName = "test-region"
+	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 2)
+	cloudmock.ExpectRegionResolutionTimes(mc, testRegionID, 1)
+
+	mc.Volumes.On("Create", mock.Anything, mock.Anything).
+		Return(&edgecloud.TaskResponse{Tasks: []string{"task-vol-1"}}, nil, nil)
+
+	mc.Tasks.On("Get", mock.Anything, "task-vol-1").
+		Return(&edgecloud.Task{
+			State: edgecloud.TaskStateFinished,
+			CreatedResources: map[string]interface{}{
+				"volumes": []interface{}{volID},
+			},
+		}, nil, nil)
+
+	mc.Volumes.On("Get", mock.Anything, volID).
+		Return(sampleVolume(volID, "test-volume", 10), nil, nil)
+
+	return support.ResourceCase[*cloudmock.MockedCloud]{
+		Name:    "create with provider default project and region",
+		Op:      support.OpApply,
+		Prepare: func() *cloudmock.MockedCloud { return mc },
+		NewConfig: cloud.Merge(
+			cloud.WithName("test-volume"),
+			cloud.WithSize(10),
+			cloud.WithTypeName("standard"),
+		),
+		Check: func(t *testing.T, state *terraform.InstanceState, diags diag.Diagnostics, _ *cloudmock.MockedCloud) {
+			support.RequireNoDiags(t, diags)
+			support.RequireStateID(t, state, volID)
+			support.RequireStateAttrs(t, state, map[string]string{
+				"project_id":  fmt.Sprint(testProjectID),
+				"region_id":   fmt.Sprint(testRegionID),
+				"region_name": "test-region",
+			})
+		},
+	}
+}
+
 func volumeDeleteCase(volID string) support.ResourceCase[*cloudmock.MockedCloud] {
 	mc := cloudmock.NewMockedCloud(testProjectID, testRegionID)
 	cloudmock.ExpectProjectResolutionTimes(mc, testProjectID, 1)
@@ -276,6 +318,7 @@ func TestIntegrationVolume_TableDriven(t *testing.T) {
 
 	cases := []support.ResourceCase[*cloudmock.MockedCloud]{
 		volumeCreateCase(testVolumeID),
+		volumeCreateWithProviderDefaultsCase(testVolumeID),
 		volumeReadCase(testVolumeID),
 		volumeCreateAPIFailureCase(),
 		volumeUpdateSizeCase(testVolumeID),
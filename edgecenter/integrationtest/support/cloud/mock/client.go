@@ -40,6 +40,7 @@ type MockedCloud struct {
 	Floatingips       *FloatingIPsService
 	SecurityGroups    *SecurityGroupsService
 	Snapshots         *SnapshotsService
+	Images            *ImagesService
 	ReservedFixedIP   *ReservedFixedIPsService
 	L7Policies        *L7PoliciesService
 	L7Rules           *L7RulesService
@@ -93,6 +94,7 @@ func NewMockedCloud(projectID, regionID int) *MockedCloud {
 		Floatingips:       &FloatingIPsService{},
 		SecurityGroups:    &SecurityGroupsService{},
 		Snapshots:         &SnapshotsService{},
+		Images:            &ImagesService{},
 		ReservedFixedIP:   &ReservedFixedIPsService{},
 		L7Policies:        &L7PoliciesService{},
 		L7Rules:           &L7RulesService{},
@@ -116,6 +118,7 @@ func NewMockedCloud(projectID, regionID int) *MockedCloud {
 		&mc.Floatingips.Mock,
 		&mc.SecurityGroups.Mock,
 		&mc.Snapshots.Mock,
+		&mc.Images.Mock,
 		&mc.ReservedFixedIP.Mock,
 		&mc.L7Policies.Mock,
 		&mc.L7Rules.Mock,
@@ -138,6 +141,7 @@ func NewMockedCloud(projectID, regionID int) *MockedCloud {
 	client.Floatingips = mc.Floatingips
 	client.SecurityGroups = mc.SecurityGroups
 	client.Snapshots = mc.Snapshots
+	client.Images = mc.Images
 	client.ReservedFixedIP = mc.ReservedFixedIP
 	client.L7Policies = mc.L7Policies
 	client.L7Rules = mc.L7Rules
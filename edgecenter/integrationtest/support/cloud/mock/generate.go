@@ -13,6 +13,7 @@ package cloudmock
 //go:generate go run github.com/vektra/mockery/v2 --name=FloatingIPsService --srcpkg=github.com/Edge-Center/edgecentercloud-go/v2 --output=. --outpkg=cloudmock --testonly=false --with-expecter=false --log-level=error
 //go:generate go run github.com/vektra/mockery/v2 --name=SecurityGroupsService --srcpkg=github.com/Edge-Center/edgecentercloud-go/v2 --output=. --outpkg=cloudmock --testonly=false --with-expecter=false --log-level=error
 //go:generate go run github.com/vektra/mockery/v2 --name=SnapshotsService --srcpkg=github.com/Edge-Center/edgecentercloud-go/v2 --output=. --outpkg=cloudmock --testonly=false --with-expecter=false --log-level=error
+//go:generate go run github.com/vektra/mockery/v2 --name=ImagesService --srcpkg=github.com/Edge-Center/edgecentercloud-go/v2 --output=. --outpkg=cloudmock --testonly=false --with-expecter=false --log-level=error
 //go:generate go run github.com/vektra/mockery/v2 --name=ReservedFixedIPsService --srcpkg=github.com/Edge-Center/edgecentercloud-go/v2 --output=. --outpkg=cloudmock --testonly=false --with-expecter=false --log-level=error
 //go:generate go run github.com/vektra/mockery/v2 --name=L7PoliciesService --srcpkg=github.com/Edge-Center/edgecentercloud-go/v2 --output=. --outpkg=cloudmock --testonly=false --with-expecter=false --log-level=error
 //go:generate go run github.com/vektra/mockery/v2 --name=L7RulesService --srcpkg=github.com/Edge-Center/edgecentercloud-go/v2 --output=. --outpkg=cloudmock --testonly=false --with-expecter=false --log-level=error
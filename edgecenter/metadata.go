@@ -1,6 +1,13 @@
 package edgecenter
 
-import "github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/shared/meta"
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/shared/meta"
+)
 
 func PrepareMetadata(apiMetadataRaw interface{}) (map[string]string, []map[string]interface{}) {
 	return meta.PrepareMetadata(apiMetadataRaw)
@@ -10,6 +17,147 @@ func PrepareMetadataReadonly(apiMetadataRaw interface{}) []map[string]interface{
 	return meta.PrepareMetadataReadonly(apiMetadataRaw)
 }
 
+func WithoutDefaultMetadata(apiMetadata map[string]string, m interface{}) map[string]string {
+	return meta.WithoutDefaultMetadata(apiMetadata, defaultMetadata(m), nil)
+}
+
 func MapInterfaceToMapString(mapInterface interface{}) (*map[string]string, error) {
 	return meta.MapInterfaceToMapString(mapInterface) //nolint:wrapcheck
 }
+
+// metadataGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type metadataGetter interface {
+	Get(key string) interface{}
+}
+
+// metadataFields names the fields in which a resource sets its own metadata.
+type metadataFields struct {
+	// mapField is the map of the resource metadata.
+	mapField string
+	// listField is a deprecated list of key/value blocks used instead of mapField, if any.
+	listField string
+}
+
+var (
+	cloudMetadata      = metadataFields{mapField: MetadataMapField}
+	instanceMetadata   = metadataFields{mapField: MetadataMapField, listField: MetadataField}
+	instanceV2Metadata = metadataFields{mapField: MetadataField}
+	// instanceGroupMetadata is set on every member of an edgecenter_instance_group.
+	instanceGroupMetadata = metadataFields{mapField: MetadataField}
+)
+
+// metadataAllSchema is the computed metadata_all attribute of metadata-capable resources.
+func metadataAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Description: "All metadata of the resource, including the `default_metadata` of the provider.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func defaultMetadata(m interface{}) map[string]string {
+	if config, ok := m.(*Config); ok && config != nil {
+		return config.DefaultMetadata
+	}
+
+	return nil
+}
+
+// configured returns the metadata set on the resource itself.
+func (f metadataFields) configured(d metadataGetter) map[string]interface{} {
+	if f.listField != "" {
+		if items, ok := d.Get(f.listField).([]interface{}); ok && len(items) > 0 {
+			md := make(map[string]interface{}, len(items))
+			for _, item := range items {
+				kv := item.(map[string]interface{})
+				md[kv[KeyField].(string)] = kv[ValueField]
+			}
+
+			return md
+		}
+	}
+	md, _ := d.Get(f.mapField).(map[string]interface{})
+
+	return md
+}
+
+// merged returns the metadata to send to the API: the provider defaults overlaid with the resource metadata.
+func (f metadataFields) merged(d metadataGetter, m interface{}) map[string]string {
+	return meta.MergeMetadata(defaultMetadata(m), f.configured(d))
+}
+
+// hasChange reports whether the metadata to send to the API changed, including through the provider defaults.
+func (f metadataFields) hasChange(d *schema.ResourceData) bool {
+	if f.listField != "" && d.HasChange(f.listField) {
+		return true
+	}
+
+	return d.HasChanges(f.mapField, MetadataAllField)
+}
+
+// customizeDiff plans metadata_all, so a change of the provider defaults shows up as an update.
+func (f metadataFields) customizeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(f.mapField) || (f.listField != "" && !d.NewValueKnown(f.listField)) {
+		if err := d.SetNewComputed(MetadataAllField); err != nil {
+			return fmt.Errorf("mark %s as computed: %w", MetadataAllField, err)
+		}
+		return nil
+	}
+
+	merged := f.merged(d, m)
+	oldRaw, _ := d.GetChange(MetadataAllField)
+	if old, _ := oldRaw.(map[string]interface{}); d.Id() != "" && sameMetadata(old, merged) {
+		return nil
+	}
+	if err := d.SetNew(MetadataAllField, merged); err != nil {
+		return fmt.Errorf("set %s: %w", MetadataAllField, err)
+	}
+
+	return nil
+}
+
+func sameMetadata(state map[string]interface{}, md map[string]string) bool {
+	if len(state) != len(md) {
+		return false
+	}
+	for k, v := range md {
+		if sv, ok := state[k].(string); !ok || sv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// setFromAPI stores the metadata read from the API. Keys that only come from the provider
+// defaults go to metadata_all alone, so they never show up as drift of mapField.
+func (f metadataFields) setFromAPI(d *schema.ResourceData, m interface{}, apiMetadata map[string]string) error {
+	own := meta.WithoutDefaultMetadata(apiMetadata, defaultMetadata(m), f.configured(d))
+	if err := d.Set(f.mapField, own); err != nil {
+		return fmt.Errorf("set %s: %w", f.mapField, err)
+	}
+	if err := d.Set(MetadataAllField, apiMetadata); err != nil {
+		return fmt.Errorf("set %s: %w", MetadataAllField, err)
+	}
+
+	return nil
+}
+
+// setManagedFromAPI stores in metadata_all the keys of apiMetadata that the resource sets, itself
+// or through the provider defaults. It is used by resources that only read back the keys they set.
+func (f metadataFields) setManagedFromAPI(d *schema.ResourceData, m interface{}, apiMetadata map[string]interface{}) error {
+	managed := make(map[string]interface{})
+	for k := range f.merged(d, m) {
+		if v, ok := apiMetadata[k]; ok {
+			managed[k] = v
+		}
+	}
+	if err := d.Set(MetadataAllField, managed); err != nil {
+		return fmt.Errorf("set %s: %w", MetadataAllField, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package edgecenter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func metadataTestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		MetadataMapField: {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		MetadataAllField: metadataAllSchema(),
+		MetadataField: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+				KeyField:   {Type: schema.TypeString, Required: true},
+				ValueField: {Type: schema.TypeString, Required: true},
+			}},
+		},
+	}
+}
+
+func TestMetadataFieldsMerged(t *testing.T) {
+	config := &Config{DefaultMetadata: map[string]string{"owner": "platform", "env": "prod"}}
+
+	d := schema.TestResourceDataRaw(t, metadataTestSchema(), map[string]interface{}{
+		MetadataMapField: map[string]interface{}{"env": "staging"},
+	})
+	want := map[string]string{"owner": "platform", "env": "staging"}
+	if got := cloudMetadata.merged(d, config); !reflect.DeepEqual(got, want) {
+		t.Errorf("merged = %v, want %v", got, want)
+	}
+
+	legacy := schema.TestResourceDataRaw(t, metadataTestSchema(), map[string]interface{}{
+		MetadataField: []interface{}{map[string]interface{}{KeyField: "app", ValueField: "web"}},
+	})
+	want = map[string]string{"owner": "platform", "env": "prod", "app": "web"}
+	if got := instanceMetadata.merged(legacy, config); !reflect.DeepEqual(got, want) {
+		t.Errorf("merged legacy = %v, want %v", got, want)
+	}
+
+	if got := cloudMetadata.merged(d, nil); !reflect.DeepEqual(got, map[string]string{"env": "staging"}) {
+		t.Errorf("merged without provider config = %v", got)
+	}
+}
+
+func TestMetadataFieldsSetFromAPI(t *testing.T) {
+	config := &Config{DefaultMetadata: map[string]string{"owner": "platform", "env": "prod"}}
+	d := schema.TestResourceDataRaw(t, metadataTestSchema(), map[string]interface{}{
+		MetadataMapField: map[string]interface{}{"app": "web"},
+	})
+
+	api := map[string]string{"owner": "platform", "env": "staging", "app": "web"}
+	if err := cloudMetadata.setFromAPI(d, config, api); err != nil {
+		t.Fatalf("setFromAPI: %v", err)
+	}
+
+	wantMap := map[string]interface{}{"env": "staging", "app": "web"}
+	if got := d.Get(MetadataMapField); !reflect.DeepEqual(got, wantMap) {
+		t.Errorf("%s = %v, want %v", MetadataMapField, got, wantMap)
+	}
+	wantAll := map[string]interface{}{"owner": "platform", "env": "staging", "app": "web"}
+	if got := d.Get(MetadataAllField); !reflect.DeepEqual(got, wantAll) {
+		t.Errorf("%s = %v, want %v", MetadataAllField, got, wantAll)
+	}
+}
+
+func TestMetadataFieldsSetManagedFromAPI(t *testing.T) {
+	config := &Config{DefaultMetadata: map[string]string{"owner": "platform"}}
+	d := schema.TestResourceDataRaw(t, metadataTestSchema(), map[string]interface{}{
+		MetadataMapField: map[string]interface{}{"app": "web"},
+	})
+
+	api := map[string]interface{}{"owner": "platform", "app": "web", "unmanaged": "x"}
+	if err := instanceMetadata.setManagedFromAPI(d, config, api); err != nil {
+		t.Fatalf("setManagedFromAPI: %v", err)
+	}
+
+	want := map[string]interface{}{"owner": "platform", "app": "web"}
+	if got := d.Get(MetadataAllField); !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %v, want %v", MetadataAllField, got, want)
+	}
+}
@@ -0,0 +1,48 @@
+package edgecenter
+
+import (
+	"log"
+	"sync"
+)
+
+// routerLocks serializes the changes of the interfaces and routes of a router, keyed by
+// the router ID. The API rejects concurrent updates of the same router, and Terraform
+// applies the router interface and route resources of one router in parallel.
+var routerLocks = newMutexKV()
+
+// mutexKV is a set of mutexes keyed by string, created on first use.
+type mutexKV struct {
+	mu    sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{store: make(map[string]*sync.Mutex)}
+}
+
+// Lock locks the mutex of key.
+func (m *mutexKV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+// Unlock unlocks the mutex of key.
+func (m *mutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mu, ok := m.store[key]
+	if !ok {
+		mu = &sync.Mutex{}
+		m.store[key] = mu
+	}
+
+	return mu
+}
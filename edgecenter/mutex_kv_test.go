@@ -0,0 +1,63 @@
+package edgecenter
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMutexKVSerializesSameKey(t *testing.T) {
+	m := newMutexKV()
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		active  int
+		maxSeen int
+	)
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Lock("router-1")
+			defer m.Unlock("router-1")
+
+			mu.Lock()
+			active++
+			if active > maxSeen {
+				maxSeen = active
+			}
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			active--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if maxSeen != 1 {
+		t.Errorf("%d holders of the same key at once, want 1", maxSeen)
+	}
+}
+
+func TestMutexKVDoesNotBlockOtherKeys(t *testing.T) {
+	m := newMutexKV()
+	m.Lock("router-1")
+	defer m.Unlock("router-1")
+
+	done := make(chan struct{})
+	go func() {
+		m.Lock("router-2")
+		m.Unlock("router-2")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("lock of another key is blocked")
+	}
+}
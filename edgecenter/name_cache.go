@@ -0,0 +1,82 @@
+package edgecenter
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// nameCache memoizes project and region resolution for the lifetime of a provider
+// instance. Every resource resolves its project and region on each CRUD call, so
+// without it a refresh lists all projects and regions once per resource.
+// Concurrent lookups of the same key share a single API call, and failed lookups
+// are not cached so that a transient error does not stick until the next run.
+type nameCache struct {
+	mu    sync.RWMutex
+	ids   map[string]int
+	group singleflight.Group
+}
+
+func newNameCache() *nameCache {
+	return &nameCache{ids: make(map[string]int)}
+}
+
+func projectCacheKey(projectID int, projectName string) string {
+	if projectID != 0 {
+		return fmt.Sprintf("project#%d", projectID)
+	}
+
+	return "project:" + projectName
+}
+
+func regionCacheKey(regionID int, regionName string) string {
+	if regionID != 0 {
+		return fmt.Sprintf("region#%d", regionID)
+	}
+
+	return "region:" + regionName
+}
+
+// resolve returns the cached ID for key or calls fetch once for all concurrent callers.
+// A nil cache always calls fetch.
+func (c *nameCache) resolve(key string, fetch func() (int, error)) (int, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	c.mu.RLock()
+	id, ok := c.ids[key]
+	c.mu.RUnlock()
+	if ok {
+		log.Printf("[DEBUG] Resolved %s from cache: %d", key, id)
+		return id, nil
+	}
+
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		// The lookup may have completed between the read above and joining the group.
+		c.mu.RLock()
+		id, ok := c.ids[key]
+		c.mu.RUnlock()
+		if ok {
+			return id, nil
+		}
+
+		id, err := fetch()
+		if err != nil {
+			return 0, err
+		}
+
+		c.mu.Lock()
+		c.ids[key] = id
+		c.mu.Unlock()
+
+		return id, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return v.(int), nil
+}
@@ -0,0 +1,122 @@
+package edgecenter
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestNameCacheResolveMemoizes(t *testing.T) {
+	c := newNameCache()
+	var calls atomic.Int32
+	fetch := func() (int, error) {
+		calls.Add(1)
+		return 7, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		id, err := c.resolve(regionCacheKey(0, "Luxembourg"), fetch)
+		if err != nil {
+			t.Fatalf("resolve: %v", err)
+		}
+		if id != 7 {
+			t.Errorf("id = %d, want 7", id)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("fetch called %d times, want 1", got)
+	}
+}
+
+func TestNameCacheResolveDeduplicatesConcurrentLookups(t *testing.T) {
+	c := newNameCache()
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fetch := func() (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	const callers = 16
+	var wg sync.WaitGroup
+	ids := make([]int, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ids[i], _ = c.resolve(projectCacheKey(0, "default"), fetch)
+		}(i)
+	}
+	// Let the goroutines pile up on the in-flight call before it completes.
+	for calls.Load() == 0 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("fetch called %d times, want 1", got)
+	}
+	for i, id := range ids {
+		if id != 42 {
+			t.Errorf("caller %d got id %d, want 42", i, id)
+		}
+	}
+}
+
+func TestNameCacheResolveDoesNotCacheErrors(t *testing.T) {
+	c := newNameCache()
+	fail := true
+	fetch := func() (int, error) {
+		if fail {
+			return 0, errors.New("temporary failure")
+		}
+		return 3, nil
+	}
+
+	if _, err := c.resolve(projectCacheKey(0, "default"), fetch); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	fail = false
+	id, err := c.resolve(projectCacheKey(0, "default"), fetch)
+	if err != nil {
+		t.Fatalf("resolve after failure: %v", err)
+	}
+	if id != 3 {
+		t.Errorf("id = %d, want 3", id)
+	}
+}
+
+func TestNameCacheKeysDoNotCollide(t *testing.T) {
+	keys := map[string]bool{}
+	for _, k := range []string{
+		projectCacheKey(1, ""),
+		projectCacheKey(0, "1"),
+		regionCacheKey(1, ""),
+		regionCacheKey(0, "1"),
+	} {
+		if keys[k] {
+			t.Errorf("duplicate cache key %q", k)
+		}
+		keys[k] = true
+	}
+}
+
+func TestNilNameCacheAlwaysFetches(t *testing.T) {
+	var c *nameCache
+	calls := 0
+	fetch := func() (int, error) {
+		calls++
+		return 1, nil
+	}
+
+	_, _ = c.resolve(regionCacheKey(0, "Luxembourg"), fetch)
+	_, _ = c.resolve(regionCacheKey(0, "Luxembourg"), fetch)
+	if calls != 2 {
+		t.Errorf("fetch called %d times, want 2", calls)
+	}
+}
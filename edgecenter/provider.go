@@ -6,22 +6,24 @@ import (
 	"log"
 	"net/http"
 	"net/url"
-	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	dnssdk "github.com/Edge-Center/edgecenter-dns-sdk-go"
 	storageSDK "github.com/Edge-Center/edgecenter-storage-sdk-go"
 	cdn "github.com/Edge-Center/edgecentercdn-go"
-	eccdnProvider "github.com/Edge-Center/edgecentercdn-go/edgecenter/provider"
 	edgecloud "github.com/Edge-Center/edgecentercloud-go"
 	ec "github.com/Edge-Center/edgecentercloud-go/edgecenter"
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 	rmon "github.com/Edge-Center/edgecenteredgemon-go"
-	ermonProvider "github.com/Edge-Center/edgecenteredgemon-go/edgecenter/provider"
 	protectionSDK "github.com/Edge-Center/edgecenterprotection-go"
 	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/shared/meta"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/shared/profile"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/shared/transport"
 	"github.com/Edge-Center/terraform-provider-edgecenter/internal/versioncheck"
 )
 
@@ -29,6 +31,19 @@ const (
 	ProviderOptPermanentToken        = "permanent_api_token"
 	ProviderOptSkipCredsAuthErr      = "ignore_creds_auth_error" // nolint: gosec
 	ProviderOptSingleAPIEndpoint     = "api_endpoint"
+	ProviderOptMaxRetries            = "max_retries"
+	ProviderOptRetryMaxBackoff       = "retry_max_backoff"
+	ProviderOptRequestsPerSecond     = "requests_per_second"
+	ProviderOptCABundleFile          = "ca_bundle_file"
+	ProviderOptInsecureSkipVerify    = "insecure_skip_verify"
+	ProviderOptProxyURL              = "proxy_url"
+	ProviderOptClientCertFile        = "client_cert_file"
+	ProviderOptClientKeyFile         = "client_key_file"
+	ProviderOptProfile               = "profile"
+	ProviderOptSharedConfigFile      = "shared_config_file"
+	ProviderOptTokenFile             = "token_file"
+	ProviderOptTokenCommand          = "token_command"
+	ProviderOptQuotaPreflight        = "quota_preflight"
 	RegionIDField                    = "region_id"
 	RegionNameField                  = "region_name"
 	ProjectIDField                   = "project_id"
@@ -59,6 +74,8 @@ const (
 	PasswordField                    = "password"
 	UsernameField                    = "username"
 	MetadataMapField                 = "metadata_map"
+	MetadataAllField                 = "metadata_all"
+	DefaultMetadataField             = "default_metadata"
 	IPAddressField                   = "ip_address"
 	SecurityGroupField               = "security_group"
 	SecurityGroupsField              = "security_groups"
@@ -97,6 +114,13 @@ const (
 	SharedField                      = "shared"
 	MetadataKVField                  = "metadata_kv"
 	MetadataKField                   = "metadata_k"
+	NameRegexField                   = "name_regex"
+	InstancesField                   = "instances"
+	VolumesField                     = "volumes"
+	SnapshotsField                   = "snapshots"
+	LifecyclePolicyIDField           = "lifecycle_policy_id"
+	FloatingIPsField                 = "floating_ips"
+	LoadBalancersField               = "load_balancers"
 	NetworksField                    = "networks"
 	DefaultField                     = "default"
 	ExternalField                    = "external"
@@ -248,6 +272,32 @@ func ProviderSchema() map[string]*schema.Schema {
 			Description: "A permanent [API-token](https://support.edgecenter.ru/knowledge_base/item/257788)",
 			DefaultFunc: schema.EnvDefaultFunc("EC_PERMANENT_TOKEN", nil),
 		},
+		ProviderOptTokenFile: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{ProviderOptPermanentToken, ProviderOptTokenCommand},
+			Description:   "Path to a file holding the permanent API token.",
+			DefaultFunc:   schema.EnvDefaultFunc("EC_TOKEN_FILE", nil),
+		},
+		ProviderOptTokenCommand: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{ProviderOptPermanentToken, ProviderOptTokenFile},
+			Description:   "Shell command printing the permanent API token, e.g. a password manager CLI.",
+			DefaultFunc:   schema.EnvDefaultFunc("EC_TOKEN_COMMAND", nil),
+		},
+		ProviderOptProfile: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the profile in `shared_config_file` to take the token and API endpoints from.",
+			DefaultFunc: schema.EnvDefaultFunc("EC_PROFILE", nil),
+		},
+		ProviderOptSharedConfigFile: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path to the YAML file with named profiles.",
+			DefaultFunc: schema.EnvDefaultFunc("EC_SHARED_CONFIG_FILE", profile.DefaultConfigFile),
+		},
 		ProviderOptSingleAPIEndpoint: {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -318,6 +368,98 @@ func ProviderSchema() map[string]*schema.Schema {
 			Description: "Protection API (define only if you want to override Protection API endpoint)",
 			DefaultFunc: schema.EnvDefaultFunc("EC_PROTECTION_API", ""),
 		},
+		ProviderOptMaxRetries: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "How many times an API request is retried when the API answers 429, or 502 or 503 to an idempotent request. Set to 0 to disable retries.",
+			DefaultFunc:  schema.EnvDefaultFunc("EC_MAX_RETRIES", transport.DefaultMaxRetries),
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		ProviderOptRetryMaxBackoff: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The longest delay between two retries, including a delay requested by the API in the Retry-After header, e.g. `30s` or `2m`.",
+			DefaultFunc:  schema.EnvDefaultFunc("EC_RETRY_MAX_BACKOFF", transport.DefaultMaxBackoff.String()),
+			ValidateFunc: validateDurationFunc,
+		},
+		ProviderOptRequestsPerSecond: {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "The maximum rate of API requests the provider sends across all products. 0 means no limit.",
+			DefaultFunc:  schema.EnvDefaultFunc("EC_REQUESTS_PER_SECOND", 0),
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+		ProviderOptCABundleFile: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path to a PEM file with CA certificates to trust in addition to the system ones, e.g. the CA of a TLS-intercepting proxy.",
+			DefaultFunc: schema.EnvDefaultFunc("EC_CA_BUNDLE_FILE", nil),
+		},
+		ProviderOptInsecureSkipVerify: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Do not verify the TLS certificates of the API. Only meant for debugging.",
+			DefaultFunc: schema.EnvDefaultFunc("EC_INSECURE_SKIP_VERIFY", false),
+		},
+		ProviderOptProxyURL: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "URL of the HTTP proxy for all API requests. When not set, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.",
+			DefaultFunc:  schema.EnvDefaultFunc("EC_PROXY_URL", nil),
+			ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+		},
+		ProviderOptClientCertFile: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{ProviderOptClientKeyFile},
+			Description:  "Path to a PEM client certificate presented to the API or the proxy.",
+			DefaultFunc:  schema.EnvDefaultFunc("EC_CLIENT_CERT_FILE", nil),
+		},
+		ProviderOptClientKeyFile: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{ProviderOptClientCertFile},
+			Description:  "Path to the PEM private key of `client_cert_file`.",
+			DefaultFunc:  schema.EnvDefaultFunc("EC_CLIENT_KEY_FILE", nil),
+		},
+		ProviderOptQuotaPreflight: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Sum the quota demand of the planned instances, volumes, floating IPs, load balancers and MKaaS pools per region and fail the plan when it exceeds the remaining quota.",
+			DefaultFunc: schema.EnvDefaultFunc("EC_QUOTA_PREFLIGHT", false),
+		},
+		DefaultMetadataField: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Metadata merged into `metadata_map` of every metadata-capable cloud resource. A key set in the resource wins over the default.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		ProjectIDField: {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{ProjectNameField},
+			Description:   "The default project ID for cloud resources and data sources that set neither 'project_id' nor 'project_name'.",
+		},
+		ProjectNameField: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{ProjectIDField},
+			Description:   "The default project name for cloud resources and data sources that set neither 'project_id' nor 'project_name'.",
+		},
+		RegionIDField: {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{RegionNameField},
+			Description:   "The default region ID for cloud resources and data sources that set neither 'region_id' nor 'region_name'.",
+		},
+		RegionNameField: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{RegionIDField},
+			Description:   "The default region name for cloud resources and data sources that set neither 'region_id' nor 'region_name'.",
+		},
 	}
 }
 
@@ -325,20 +467,30 @@ func (LegacyService) Resources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"edgecenter_project":                       resourceProject(),
 		"edgecenter_volume":                        resourceVolume(),
+		"edgecenter_volume_attachment":             resourceVolumeAttachment(),
+		"edgecenter_image":                         resourceImage(),
 		"edgecenter_network":                       resourceNetwork(),
 		"edgecenter_subnet":                        resourceSubnet(),
 		"edgecenter_router":                        resourceRouter(),
+		"edgecenter_router_interface":              resourceRouterInterface(),
+		"edgecenter_router_route":                  resourceRouterRoute(),
 		"edgecenter_instance":                      resourceInstance(),
 		"edgecenter_instanceV2":                    resourceInstanceV2(),
+		"edgecenter_instance_group":                resourceInstanceGroup(),
 		"edgecenter_keypair":                       resourceKeypair(),
 		"edgecenter_reservedfixedip":               resourceReservedFixedIP(),
+		"edgecenter_port":                          resourcePort(),
 		"edgecenter_floatingip":                    resourceFloatingIP(),
+		"edgecenter_floatingip_association":        resourceFloatingIPAssociation(),
 		"edgecenter_loadbalancer":                  resourceLoadBalancer(),
 		"edgecenter_loadbalancerv2":                resourceLoadBalancerV2(),
 		"edgecenter_lblistener":                    resourceLbListener(),
 		"edgecenter_lbpool":                        resourceLBPool(),
+		"edgecenter_lbpool_members":                resourceLBPoolMembers(),
 		"edgecenter_lbmember":                      resourceLBMember(),
+		"edgecenter_lb_healthmonitor":              resourceLBHealthMonitor(),
 		"edgecenter_securitygroup":                 resourceSecurityGroup(),
+		"edgecenter_securitygroup_rule":            resourceSecurityGroupRule(),
 		"edgecenter_baremetal":                     resourceBmInstance(),
 		"edgecenter_snapshot":                      resourceSnapshot(),
 		"edgecenter_servergroup":                   resourceServerGroup(),
@@ -352,28 +504,53 @@ func (LegacyService) Resources() map[string]*schema.Resource {
 	}
 }
 
+// StateMoves lists the resources that accept the state of their deprecated v1 type in a moved block.
+func (LegacyService) StateMoves() map[string]map[string]StateMoveFunc {
+	return map[string]map[string]StateMoveFunc{
+		"edgecenter_instanceV2":     {"edgecenter_instance": moveInstanceStateToV2},
+		"edgecenter_loadbalancerv2": {"edgecenter_loadbalancer": moveLoadBalancerStateToV2},
+	}
+}
+
+// EphemeralResources lists the ephemeral resources, they are served by the framework provider.
+func (LegacyService) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKeypairEphemeralResource,
+	}
+}
+
 func (LegacyService) DataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"edgecenter_project":                       dataSourceProject(),
 		"edgecenter_region":                        dataSourceRegion(),
+		"edgecenter_quota":                         dataSourceQuota(),
 		"edgecenter_availability_zone":             dataSourceAvailabilityZone(),
 		"edgecenter_flavor":                        dataSourceFlavor(),
 		"edgecenter_securitygroup":                 dataSourceSecurityGroup(),
+		"edgecenter_securitygroups":                dataSourceSecurityGroups(),
 		"edgecenter_image":                         dataSourceImage(),
 		"edgecenter_volume":                        dataSourceVolume(),
+		"edgecenter_volumes":                       dataSourceVolumes(),
 		"edgecenter_network":                       dataSourceNetwork(),
+		"edgecenter_networks":                      dataSourceNetworks(),
 		"edgecenter_subnet":                        dataSourceSubnet(),
+		"edgecenter_subnets":                       dataSourceSubnets(),
 		"edgecenter_router":                        dataSourceRouter(),
 		"edgecenter_loadbalancer":                  dataSourceLoadBalancer(),
 		"edgecenter_loadbalancerv2":                dataSourceLoadBalancerV2(),
+		"edgecenter_loadbalancers":                 dataSourceLoadBalancers(),
 		"edgecenter_lblistener":                    dataSourceLBListener(),
 		"edgecenter_lbpool":                        dataSourceLBPool(),
 		"edgecenter_instance":                      dataSourceInstance(),
 		"edgecenter_instanceV2":                    dataSourceInstanceV2(),
+		"edgecenter_instances":                     dataSourceInstances(),
 		"edgecenter_floatingip":                    dataSourceFloatingIP(),
+		"edgecenter_floatingips":                   dataSourceFloatingIPs(),
 		"edgecenter_reservedfixedip":               dataSourceReservedFixedIP(),
+		"edgecenter_port":                          dataSourcePort(),
 		"edgecenter_servergroup":                   dataSourceServerGroup(),
 		"edgecenter_snapshot":                      dataSourceSnapshot(),
+		"edgecenter_snapshots":                     dataSourceSnapshots(),
 		"edgecenter_secret":                        dataSourceSecret(),
 		"edgecenter_lb_l7policy":                   dataSourceL7Policy(),
 		"edgecenter_lb_l7rule":                     datasourceL7Rule(),
@@ -389,58 +566,81 @@ func ProviderConfigure(
 	terraformVersion string,
 	providerVersion string,
 ) (*Config, diag.Diagnostics) {
+	settings, err := newProviderSettings(d)
+	if err != nil {
+		return nil, diag.FromErr(fmt.Errorf("profile: %w", err))
+	}
+	prof := settings.profile
+
 	username := d.Get("user_name").(string)
 	password := d.Get("password").(string)
-	permanentToken := d.Get(ProviderOptPermanentToken).(string)
-	apiEndpoint := d.Get(ProviderOptSingleAPIEndpoint).(string)
-
-	cloudAPI := d.Get("edgecenter_cloud_api").(string)
-	if cloudAPI == "" {
-		cloudAPI = d.Get("edgecenter_api").(string)
+	permanentToken, err := settings.token(ctx)
+	if err != nil {
+		return nil, diag.FromErr(fmt.Errorf("permanent api token: %w", err))
 	}
+	apiEndpoint := settings.get(prof.APIEndpoint, ProviderOptSingleAPIEndpoint)
+
+	cloudAPI := settings.get(prof.CloudAPI, "edgecenter_cloud_api", "edgecenter_api")
 	if cloudAPI == "" {
 		cloudAPI = apiEndpoint + "/cloud"
 	}
 
-	cdnAPI := d.Get("edgecenter_cdn_api").(string)
+	cdnAPI := settings.get(prof.CDNAPI, "edgecenter_cdn_api")
 	if cdnAPI == "" {
 		cdnAPI = apiEndpoint
 	}
 
-	rmonAPI := d.Get("edgecenter_rmon_api").(string)
+	rmonAPI := settings.get(prof.RMONAPI, "edgecenter_rmon_api")
 	if rmonAPI == "" {
 		rmonAPI = apiEndpoint
 	}
 
-	storageAPI := d.Get("edgecenter_storage_api").(string)
+	storageAPI := settings.get(prof.StorageAPI, "edgecenter_storage_api")
 	if storageAPI == "" {
 		storageAPI = apiEndpoint + "/storage"
 	}
 
-	dnsAPI := d.Get("edgecenter_dns_api").(string)
+	dnsAPI := settings.get(prof.DNSAPI, "edgecenter_dns_api")
 	if dnsAPI == "" {
 		dnsAPI = apiEndpoint + "/dns"
 	}
 
-	protectionAPI := d.Get("edgecenter_protection_api").(string)
+	protectionAPI := settings.get(prof.ProtectionAPI, "edgecenter_protection_api")
 	if protectionAPI == "" {
 		protectionAPI = apiEndpoint + "/protection"
 	}
 
-	platform := d.Get("edgecenter_platform_api").(string)
-	if platform == "" {
-		platform = d.Get("edgecenter_platform").(string)
-	}
+	platform := settings.get(prof.PlatformAPI, "edgecenter_platform_api", "edgecenter_platform")
 	if platform == "" {
 		platform = apiEndpoint + "/iam"
 	}
 
 	userAgent := fmt.Sprintf("terraform/%s terraform-provider-edgecenter/%s", terraformVersion, providerVersion)
 
+	// retry_max_backoff is validated by the schema.
+	maxBackoff, _ := time.ParseDuration(d.Get(ProviderOptRetryMaxBackoff).(string))
+	baseTransport, err := transport.NewBase(transport.BaseOptions{
+		CABundleFile:       d.Get(ProviderOptCABundleFile).(string),
+		InsecureSkipVerify: d.Get(ProviderOptInsecureSkipVerify).(bool),
+		ProxyURL:           d.Get(ProviderOptProxyURL).(string),
+		ClientCertFile:     d.Get(ProviderOptClientCertFile).(string),
+		ClientKeyFile:      d.Get(ProviderOptClientKeyFile).(string),
+	})
+	if err != nil {
+		return nil, diag.FromErr(fmt.Errorf("http transport: %w", err))
+	}
+	httpClient := &http.Client{
+		Transport: transport.NewRetry(baseTransport, transport.RetryOptions{
+			MaxRetries:        d.Get(ProviderOptMaxRetries).(int),
+			MaxBackoff:        maxBackoff,
+			RequestsPerSecond: d.Get(ProviderOptRequestsPerSecond).(float64),
+		}),
+	}
+
 	var diags diag.Diagnostics
 
-	var err error
 	var provider *edgecloud.ProviderClient
+	cloudHTTPClient := tracedHTTPClient(httpClient, transport.SubsystemCloud)
 
 	if permanentToken != "" {
 		provider, err = ec.APITokenClient(edgecloud.APITokenOptions{
@@ -450,32 +650,42 @@ func ProviderConfigure(
 		if err != nil {
 			return nil, diag.FromErr(fmt.Errorf("edgecloud provider client create error: %w", err))
 		}
+		provider.HTTPClient = *cloudHTTPClient
 	} else {
-		provider, err = ec.AuthenticatedClient(edgecloud.AuthOptions{
-			APIURL:      cloudAPI,
-			AuthURL:     platform,
-			Username:    username,
-			Password:    password,
-			AllowReauth: true,
-		})
+		// Authentication already talks to the platform, so the client is set up before it.
+		provider, err = ec.NewECClient(cloudAPI)
+		if err == nil {
+			provider.HTTPClient = *cloudHTTPClient
+			err = ec.Authenticate(provider, edgecloud.AuthOptions{
+				APIURL:      cloudAPI,
+				AuthURL:     platform,
+				Username:    username,
+				Password:    password,
+				AllowReauth: true,
+			})
+		}
 	}
 	if err != nil {
-		provider = &edgecloud.ProviderClient{}
+		provider = &edgecloud.ProviderClient{HTTPClient: *cloudHTTPClient}
 		log.Printf("[WARN] init auth client: %s\n", err)
 	}
 
-	cdnProvider := eccdnProvider.NewClient(
-		cdnAPI,
-		eccdnProvider.WithUA(userAgent),
-		eccdnProvider.WithSignerFunc(func(req *http.Request) error {
-			for k, v := range provider.AuthenticatedHeaders() {
-				req.Header.Set(k, v)
-			}
+	signer := func(req *http.Request) error {
+		for k, v := range provider.AuthenticatedHeaders() {
+			req.Header.Set(k, v)
+		}
 
-			return nil
-		}),
-	)
-	cdnService := cdn.NewService(cdnProvider)
+		return nil
+	}
+
+	cdnService := cdn.NewService(newAPIRequester(
+		tracedHTTPClient(httpClient, transport.SubsystemCDN), cdnAPI, userAgent, signer, cdnAPIError,
+	))
+
+	defaultMetadata, err := MapInterfaceToMapString(d.Get(DefaultMetadataField))
+	if err != nil {
+		return nil, diag.FromErr(fmt.Errorf("default metadata: %w", err))
+	}
 
 	config := Config{
 		PermanentToken: permanentToken,
@@ -483,26 +693,23 @@ func ProviderConfigure(
 		UserAgent:      userAgent,
 		Provider:       provider,
 		CDNClient:      cdnService,
+		HTTPClient:     httpClient,
+
+		DefaultProjectID:   d.Get(ProjectIDField).(int),
+		DefaultProjectName: d.Get(ProjectNameField).(string),
+		DefaultRegionID:    d.Get(RegionIDField).(int),
+		DefaultRegionName:  d.Get(RegionNameField).(string),
+		DefaultMetadata:    *defaultMetadata,
+		QuotaPreflight:     d.Get(ProviderOptQuotaPreflight).(bool),
+
+		names:  newNameCache(),
+		quotas: newQuotaLedger(),
 	}
 
 	if rmonAPI != "" {
-		rmonProvider := ermonProvider.NewClient(
-			rmonAPI,
-			ermonProvider.WithUserAgent(userAgent),
-			ermonProvider.WithSignerFunc(
-				func(req *http.Request) error {
-					for k, v := range provider.AuthenticatedHeaders() {
-						req.Header.Set(k, v)
-					}
-					return nil
-				},
-			),
-		)
-		if err != nil {
-			return nil, diag.FromErr(fmt.Errorf("rmon api client: %w", err))
-		}
-
-		config.RmonClient = rmon.NewService(rmonProvider)
+		config.RmonClient = rmon.NewService(newAPIRequester(
+			tracedHTTPClient(httpClient, transport.SubsystemRMON), rmonAPI, userAgent, signer, statusAPIError,
+		))
 	}
 
 	if storageAPI != "" {
@@ -510,13 +717,13 @@ func ProviderConfigure(
 		if err != nil {
 			return nil, diag.FromErr(fmt.Errorf("storage api url: %w", err))
 		}
-		config.StorageClient = storageSDK.NewSDK(
+		config.StorageClient = newStorageHTTPClient(storageSDK.NewSDK(
 			stHost,
 			stPath,
 			storageSDK.WithBearerAuth(provider.AccessToken),
 			storageSDK.WithPermanentTokenAuth(func() string { return permanentToken }),
 			storageSDK.WithUserAgent(userAgent),
-		)
+		), tracedHTTPClient(httpClient, transport.SubsystemStorage))
 	}
 	if dnsAPI != "" {
 		baseURL, err := url.Parse(dnsAPI)
@@ -531,7 +738,7 @@ func ProviderConfigure(
 			authorizer,
 			func(client *dnssdk.Client) {
 				client.BaseURL = baseURL
-				client.Debug = os.Getenv("TF_LOG") == "DEBUG"
+				client.HTTPClient.Transport = transport.NewLogging(httpClient.Transport, transport.SubsystemDNS)
 			},
 			func(client *dnssdk.Client) {
 				client.UserAgent = userAgent
@@ -539,7 +746,7 @@ func ProviderConfigure(
 	}
 	if protectionAPI != "" {
 		config.ProtectionClient, err = protectionSDK.New(
-			nil,
+			tracedHTTPClient(httpClient, transport.SubsystemProtection),
 			protectionSDK.SetAPIKey(permanentToken),
 			protectionSDK.SetBaseURL(protectionAPI),
 			protectionSDK.SetUserAgent(userAgent),
@@ -574,29 +781,45 @@ func InitCloudClient(
 	if err != nil {
 		return nil, err
 	}
+	useProject := clientConf == nil || !clientConf.DoNotUseProjectID
+	useRegion := clientConf == nil || !clientConf.DoNotUseRegionID
+	projectFromProvider := useProject &&
+		applyProviderDefault(d, ProjectIDField, ProjectNameField, config.DefaultProjectID, config.DefaultProjectName)
+	regionFromProvider := useRegion &&
+		applyProviderDefault(d, RegionIDField, RegionNameField, config.DefaultRegionID, config.DefaultRegionName)
+
 	var projectID, regionID int
 	switch clientConf {
 	case nil:
-		regionID, projectID, err = GetRegionIDandProjectID(ctx, client, d)
+		regionID, projectID, err = GetRegionIDandProjectID(ctx, config, client, d)
 		if err != nil {
 			return nil, err
 		}
 	default:
 		if !clientConf.DoNotUseRegionID {
-			regionID, err = GetRegionID(ctx, client, d)
+			regionID, err = GetRegionID(ctx, config, client, d)
 			if err != nil {
 				return nil, err
 			}
 		}
 
 		if !clientConf.DoNotUseProjectID {
-			projectID, err = GetProjectID(ctx, client, d)
+			projectID, err = GetProjectID(ctx, config, client, d)
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	// Identity taken from the provider is persisted, so the state records
+	// which project and region the object actually belongs to.
+	if projectFromProvider {
+		_ = d.Set(ProjectIDField, projectID)
+	}
+	if regionFromProvider {
+		_ = d.Set(RegionIDField, regionID)
+	}
+
 	client.Region = regionID
 	client.Project = projectID
 
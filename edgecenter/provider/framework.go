@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProvider serves the resources written with terraform-plugin-framework. It shares the
+// configuration with the SDK provider: the mux server configures the SDK provider first, so its
+// client is ready when Configure runs.
+type frameworkProvider struct {
+	sdk       *schema.Provider
+	version   string
+	ephemeral []func() ephemeral.EphemeralResource
+}
+
+var _ fwprovider.ProviderWithEphemeralResources = (*frameworkProvider)(nil)
+
+func newFrameworkProvider(sdk *schema.Provider, version string, ephemeralResources []func() ephemeral.EphemeralResource) *frameworkProvider {
+	return &frameworkProvider{sdk: sdk, version: version, ephemeral: ephemeralResources}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "edgecenter"
+	resp.Version = p.version
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	s, err := frameworkProviderSchema(p.sdk.Schema)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Convert Provider Schema", err.Error())
+		return
+	}
+	resp.Schema = s
+}
+
+func (p *frameworkProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	meta := p.sdk.Meta()
+	resp.DataSourceData = meta
+	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
+}
+
+func (p *frameworkProvider) Resources(context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return p.ephemeral
+}
+
+// frameworkProviderSchema converts the provider schema of the SDK provider. The mux server
+// requires every server to return the same provider schema, so the attributes are taken from the
+// schema Terraform gets from the SDK provider instead of being declared twice. The values are only
+// read by the SDK provider.
+func frameworkProviderSchema(sdkSchema map[string]*schema.Schema) (fwschema.Schema, error) {
+	block := schema.InternalMap(sdkSchema).CoreConfigSchema()
+	if len(block.BlockTypes) > 0 {
+		return fwschema.Schema{}, errors.New("nested blocks are not supported in the provider schema")
+	}
+
+	attributes := make(map[string]fwschema.Attribute, len(block.Attributes))
+	for name, a := range block.Attributes {
+		deprecation := ""
+		if a.Deprecated {
+			deprecation = sdkSchema[name].Deprecated
+		}
+
+		switch {
+		case a.Type == cty.String:
+			attributes[name] = fwschema.StringAttribute{
+				Optional:           a.Optional,
+				Required:           a.Required,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: deprecation,
+			}
+		case a.Type == cty.Number:
+			attributes[name] = fwschema.NumberAttribute{
+				Optional:           a.Optional,
+				Required:           a.Required,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: deprecation,
+			}
+		case a.Type == cty.Bool:
+			attributes[name] = fwschema.BoolAttribute{
+				Optional:           a.Optional,
+				Required:           a.Required,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: deprecation,
+			}
+		case a.Type.Equals(cty.Map(cty.String)):
+			attributes[name] = fwschema.MapAttribute{
+				ElementType:        types.StringType,
+				Optional:           a.Optional,
+				Required:           a.Required,
+				Sensitive:          a.Sensitive,
+				Description:        a.Description,
+				DeprecationMessage: deprecation,
+			}
+		default:
+			return fwschema.Schema{}, fmt.Errorf("attribute %s: unsupported type %s", name, a.Type.FriendlyName())
+		}
+	}
+
+	return fwschema.Schema{Attributes: attributes}, nil
+}
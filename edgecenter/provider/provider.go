@@ -19,12 +19,8 @@ import (
 
 const devVersion = "dev"
 
-func Provider() *schema.Provider {
-	return ProviderWithVersion(devVersion)
-}
-
-func ProviderWithVersion(version string) *schema.Provider {
-	resources, dataSources := registerAll(
+func services() []Registrar {
+	return []Registrar{
 		edgecenter.LegacyService{},
 		edgemon.Service{},
 		cdn.Service{},
@@ -34,7 +30,18 @@ func ProviderWithVersion(version string) *schema.Provider {
 		reseller.Service{},
 		dbaas.Service{},
 		mkaas.Service{},
-	)
+	}
+}
+
+func Provider() *schema.Provider {
+	return ProviderWithVersion(devVersion)
+}
+
+func ProviderWithVersion(version string) *schema.Provider {
+	resources, dataSources := registerAll(services()...)
+	for _, r := range resources {
+		edgecenter.WithProviderScopeDiff(r)
+	}
 
 	p := &schema.Provider{
 		Schema:         edgecenter.ProviderSchema(),
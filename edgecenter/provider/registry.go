@@ -3,7 +3,10 @@ package provider
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
 )
 
 type Registrar interface {
@@ -12,6 +15,18 @@ type Registrar interface {
 	DataSources() map[string]*schema.Resource
 }
 
+// StateMover is implemented by services with resources that accept the state of another
+// resource type in a moved block. StateMoves maps a target resource type to its source types.
+type StateMover interface {
+	StateMoves() map[string]map[string]edgecenter.StateMoveFunc
+}
+
+// EphemeralRegistrar is implemented by services with ephemeral resources. The SDK doesn't
+// support them, so they are served by the framework provider.
+type EphemeralRegistrar interface {
+	EphemeralResources() []func() ephemeral.EphemeralResource
+}
+
 func registerAll(services ...Registrar) (map[string]*schema.Resource, map[string]*schema.Resource) {
 	resources := make(map[string]*schema.Resource)
 	dataSources := make(map[string]*schema.Resource)
@@ -33,3 +48,34 @@ func registerAll(services ...Registrar) (map[string]*schema.Resource, map[string
 
 	return resources, dataSources
 }
+
+func registerMoves(services ...Registrar) map[string]map[string]edgecenter.StateMoveFunc {
+	moves := make(map[string]map[string]edgecenter.StateMoveFunc)
+
+	for _, svc := range services {
+		mover, ok := svc.(StateMover)
+		if !ok {
+			continue
+		}
+		for target, sources := range mover.StateMoves() {
+			if _, dup := moves[target]; dup {
+				panic(fmt.Sprintf("state moves to %q registered by multiple services (last: %s)", target, svc.Name()))
+			}
+			moves[target] = sources
+		}
+	}
+
+	return moves
+}
+
+func registerEphemeral(services ...Registrar) []func() ephemeral.EphemeralResource {
+	var resources []func() ephemeral.EphemeralResource
+
+	for _, svc := range services {
+		if registrar, ok := svc.(EphemeralRegistrar); ok {
+			resources = append(resources, registrar.EphemeralResources()...)
+		}
+	}
+
+	return resources
+}
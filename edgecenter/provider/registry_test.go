@@ -3,7 +3,10 @@ package provider
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
 )
 
 type stubService struct {
@@ -16,6 +19,22 @@ func (s stubService) Name() string                             { return s.name }
 func (s stubService) Resources() map[string]*schema.Resource   { return s.resources }
 func (s stubService) DataSources() map[string]*schema.Resource { return s.dataSources }
 
+type stubMover struct {
+	stubService
+	moves map[string]map[string]edgecenter.StateMoveFunc
+}
+
+func (s stubMover) StateMoves() map[string]map[string]edgecenter.StateMoveFunc { return s.moves }
+
+type stubEphemeralRegistrar struct {
+	stubService
+	ephemeral []func() ephemeral.EphemeralResource
+}
+
+func (s stubEphemeralRegistrar) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return s.ephemeral
+}
+
 func TestRegisterAllMergesServices(t *testing.T) {
 	t.Parallel()
 
@@ -51,3 +70,50 @@ func TestRegisterAllPanicsOnDuplicateResource(t *testing.T) {
 
 	registerAll(a, b)
 }
+
+func TestRegisterMovesSkipsServicesWithoutMoves(t *testing.T) {
+	t.Parallel()
+
+	move := func(source map[string]interface{}) (map[string]interface{}, error) { return source, nil }
+	a := stubService{name: "a"}
+	b := stubMover{stubService: stubService{name: "b"}, moves: map[string]map[string]edgecenter.StateMoveFunc{
+		"edgecenter_b": {"edgecenter_a": move},
+	}}
+
+	moves := registerMoves(a, b)
+
+	if len(moves) != 1 || moves["edgecenter_b"]["edgecenter_a"] == nil {
+		t.Fatalf("moves not merged: %v", moves)
+	}
+}
+
+func TestRegisterMovesPanicsOnDuplicateTarget(t *testing.T) {
+	t.Parallel()
+
+	moves := map[string]map[string]edgecenter.StateMoveFunc{"edgecenter_dup": {}}
+	a := stubMover{stubService: stubService{name: "a"}, moves: moves}
+	b := stubMover{stubService: stubService{name: "b"}, moves: moves}
+
+	defer func() {
+		r := recover()
+		want := `state moves to "edgecenter_dup" registered by multiple services (last: b)`
+		if got, ok := r.(string); !ok || got != want {
+			t.Fatalf("panic = %v, want %q", r, want)
+		}
+	}()
+
+	registerMoves(a, b)
+}
+
+func TestRegisterEphemeralSkipsServicesWithoutEphemeralResources(t *testing.T) {
+	t.Parallel()
+
+	a := stubService{name: "a"}
+	b := stubEphemeralRegistrar{stubService: stubService{name: "b"}, ephemeral: []func() ephemeral.EphemeralResource{
+		edgecenter.NewKeypairEphemeralResource,
+	}}
+
+	if resources := registerEphemeral(a, b); len(resources) != 1 {
+		t.Fatalf("ephemeral resources not merged: %d", len(resources))
+	}
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+// providerType is the type part of the provider address, moves are only accepted from it.
+const providerType = "/edgecenter"
+
+// ProtoV5ProviderServer returns the factory of the server Terraform talks to. It muxes the SDK
+// provider with the framework provider, which serves what the SDK does not support.
+func ProtoV5ProviderServer(ctx context.Context, version string) (func() tfprotov5.ProviderServer, error) {
+	p := ProviderWithVersion(version)
+
+	mux, err := tf5muxserver.NewMuxServer(ctx,
+		func() tfprotov5.ProviderServer { return newMoveStateServer(p, registerMoves(services()...)) },
+		providerserver.NewProtocol5(newFrameworkProvider(p, version, registerEphemeral(services()...))),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("mux provider servers: %w", err)
+	}
+
+	return mux.ProviderServer, nil
+}
+
+// moveStateServer answers MoveResourceState for the registered moves and leaves every other
+// call to the SDK server. The mux server sends a move to the server of the target type, and the
+// targets of the registered moves are SDK resources, so the moves can't be served by the
+// framework provider until those resources are ported to it.
+type moveStateServer struct {
+	tfprotov5.ProviderServer
+
+	provider *schema.Provider
+	moves    map[string]map[string]edgecenter.StateMoveFunc
+}
+
+func newMoveStateServer(p *schema.Provider, moves map[string]map[string]edgecenter.StateMoveFunc) *moveStateServer {
+	return &moveStateServer{
+		ProviderServer: schema.NewGRPCProviderServer(p),
+		provider:       p,
+		moves:          moves,
+	}
+}
+
+func (s *moveStateServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	if req == nil {
+		return nil, errors.New("MoveResourceState request is nil")
+	}
+
+	move, ok := s.moves[req.TargetTypeName][req.SourceTypeName]
+	if !ok || !strings.HasSuffix(strings.ToLower(req.SourceProviderAddress), providerType) {
+		return s.ProviderServer.MoveResourceState(ctx, req) //nolint:wrapcheck
+	}
+
+	source, err := s.sourceState(ctx, req)
+	if err != nil {
+		return moveStateError(req, err), nil
+	}
+	target, err := move(source)
+	if err != nil {
+		return moveStateError(req, err), nil
+	}
+	rawTarget, err := json.Marshal(target)
+	if err != nil {
+		return moveStateError(req, err), nil
+	}
+
+	// The SDK decodes the target state the same way as the state stored by an older release,
+	// which drops unknown attributes and fills the missing ones.
+	upgraded, err := s.ProviderServer.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: req.TargetTypeName,
+		Version:  int64(s.provider.ResourcesMap[req.TargetTypeName].SchemaVersion),
+		RawState: &tfprotov5.RawState{JSON: rawTarget},
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &tfprotov5.MoveResourceStateResponse{
+		TargetState: upgraded.UpgradedState,
+		Diagnostics: upgraded.Diagnostics,
+	}, nil
+}
+
+// sourceState decodes the source state and upgrades it to the current schema version of its type.
+func (s *moveStateServer) sourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (map[string]interface{}, error) {
+	res, ok := s.provider.ResourcesMap[req.SourceTypeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type: %s", req.SourceTypeName)
+	}
+	if req.SourceState == nil || len(req.SourceState.JSON) == 0 {
+		return nil, errors.New("the source state is empty or in the legacy flatmap format, refresh it before the move")
+	}
+
+	var state map[string]interface{}
+	if err := json.Unmarshal(req.SourceState.JSON, &state); err != nil {
+		return nil, fmt.Errorf("decode source state: %w", err)
+	}
+
+	version := int(req.SourceSchemaVersion)
+	for _, upgrader := range res.StateUpgraders {
+		if upgrader.Version != version {
+			continue
+		}
+		upgraded, err := upgrader.Upgrade(ctx, state, s.provider.Meta())
+		if err != nil {
+			return nil, fmt.Errorf("upgrade source state from version %d: %w", version, err)
+		}
+		state = upgraded
+		version++
+	}
+
+	return state, nil
+}
+
+func moveStateError(req *tfprotov5.MoveResourceStateRequest, err error) *tfprotov5.MoveResourceStateResponse {
+	return &tfprotov5.MoveResourceStateResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Unable to Move Resource State",
+				Detail:   fmt.Sprintf("The state of %s could not be moved to %s: %s.", req.SourceTypeName, req.TargetTypeName, err),
+			},
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+const testProviderAddress = "registry.terraform.io/Edge-Center/edgecenter"
+
+func newTestMoveStateServer() *moveStateServer {
+	source := &schema.Resource{
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name":  {Type: schema.TypeString, Optional: true},
+			"old":   {Type: schema.TypeString, Optional: true},
+			"added": {Type: schema.TypeBool, Optional: true},
+		},
+	}
+	source.StateUpgraders = []schema.StateUpgrader{
+		edgecenter.StateUpgraderFromSuperset(0, source, func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			rawState["added"] = true
+			return rawState, nil
+		}),
+	}
+	target := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":  {Type: schema.TypeString, Optional: true},
+			"new":   {Type: schema.TypeString, Optional: true},
+			"added": {Type: schema.TypeBool, Optional: true},
+		},
+	}
+	p := &schema.Provider{ResourcesMap: map[string]*schema.Resource{"edgecenter_source": source, "edgecenter_target": target}}
+
+	return newMoveStateServer(p, map[string]map[string]edgecenter.StateMoveFunc{
+		"edgecenter_target": {"edgecenter_source": func(source map[string]interface{}) (map[string]interface{}, error) {
+			source["new"] = source["old"]
+			return source, nil
+		}},
+	})
+}
+
+func TestProtoV5ProviderServerMuxesSchemas(t *testing.T) {
+	t.Parallel()
+
+	serverFactory, err := ProtoV5ProviderServer(context.Background(), devVersion)
+	if err != nil {
+		t.Fatalf("ProtoV5ProviderServer: %v", err)
+	}
+
+	// The mux server reports an error when the provider schemas of the servers differ.
+	resp, err := serverFactory().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if !resp.ServerCapabilities.MoveResourceState {
+		t.Errorf("capabilities = %+v", resp.ServerCapabilities)
+	}
+	if _, ok := resp.ResourceSchemas["edgecenter_instanceV2"]; !ok {
+		t.Error("the SDK resources are missing from the schema")
+	}
+	if _, ok := resp.EphemeralResourceSchemas["edgecenter_keypair"]; !ok {
+		t.Error("the framework ephemeral resources are missing from the schema")
+	}
+}
+
+func TestMoveStateServerMovesUpgradedState(t *testing.T) {
+	t.Parallel()
+
+	s := newTestMoveStateServer()
+
+	resp, err := s.MoveResourceState(context.Background(), &tfprotov5.MoveResourceStateRequest{
+		SourceProviderAddress: testProviderAddress,
+		SourceTypeName:        "edgecenter_source",
+		SourceSchemaVersion:   0,
+		SourceState:           &tfprotov5.RawState{JSON: []byte(`{"id": "x", "name": "web", "old": "value"}`)},
+		TargetTypeName:        "edgecenter_target",
+	})
+	if err != nil {
+		t.Fatalf("MoveResourceState: %v", err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("diagnostics: %s", resp.Diagnostics[0].Detail)
+	}
+
+	ty := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id": tftypes.String, "name": tftypes.String, "new": tftypes.String, "added": tftypes.Bool,
+	}}
+	got, err := resp.TargetState.Unmarshal(ty)
+	if err != nil {
+		t.Fatalf("decode target state: %v", err)
+	}
+	want := tftypes.NewValue(ty, map[string]tftypes.Value{
+		"id":    tftypes.NewValue(tftypes.String, "x"),
+		"name":  tftypes.NewValue(tftypes.String, "web"),
+		"new":   tftypes.NewValue(tftypes.String, "value"),
+		"added": tftypes.NewValue(tftypes.Bool, true),
+	})
+	if !got.Equal(want) {
+		t.Errorf("target state = %s, want %s", got, want)
+	}
+}
+
+func TestMoveStateServerRejectsUnknownMoves(t *testing.T) {
+	t.Parallel()
+
+	s := newTestMoveStateServer()
+
+	for name, req := range map[string]*tfprotov5.MoveResourceStateRequest{
+		"unregistered pair": {
+			SourceProviderAddress: testProviderAddress,
+			SourceTypeName:        "edgecenter_target",
+			TargetTypeName:        "edgecenter_source",
+			SourceState:           &tfprotov5.RawState{JSON: []byte(`{"id": "x"}`)},
+		},
+		"other provider": {
+			SourceProviderAddress: "registry.terraform.io/hashicorp/null",
+			SourceTypeName:        "edgecenter_source",
+			TargetTypeName:        "edgecenter_target",
+			SourceState:           &tfprotov5.RawState{JSON: []byte(`{"id": "x"}`)},
+		},
+		"flatmap state": {
+			SourceProviderAddress: testProviderAddress,
+			SourceTypeName:        "edgecenter_source",
+			TargetTypeName:        "edgecenter_target",
+			SourceState:           &tfprotov5.RawState{Flatmap: map[string]string{"id": "x"}},
+		},
+	} {
+		resp, err := s.MoveResourceState(context.Background(), req)
+		if err != nil {
+			t.Fatalf("%s: MoveResourceState: %v", name, err)
+		}
+		if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Severity != tfprotov5.DiagnosticSeverityError {
+			t.Errorf("%s: diagnostics = %v, want one error", name, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/shared/profile"
+)
+
+// providerSettings resolves provider options in the order: the provider block,
+// the selected profile of the shared config file, the environment defaults.
+type providerSettings struct {
+	d       *schema.ResourceData
+	raw     cty.Value
+	profile *profile.Profile
+}
+
+func newProviderSettings(d *schema.ResourceData) (*providerSettings, error) {
+	s := &providerSettings{d: d, raw: d.GetRawConfig(), profile: &profile.Profile{}}
+
+	name := d.Get(ProviderOptProfile).(string)
+	if name == "" {
+		return s, nil
+	}
+	p, err := profile.Load(d.Get(ProviderOptSharedConfigFile).(string), name)
+	if err != nil {
+		return nil, err
+	}
+	s.profile = p
+
+	return s, nil
+}
+
+// configured reports whether key is set in the provider block itself, as opposed to
+// being filled from its environment default.
+func (s *providerSettings) configured(key string) bool {
+	if s.raw.IsNull() || !s.raw.IsKnown() || !s.raw.Type().HasAttribute(key) {
+		return false
+	}
+
+	return !s.raw.GetAttr(key).IsNull()
+}
+
+// get returns the first of keys set in the provider block, then fromProfile, then the first
+// non-empty environment default of keys. Further keys are deprecated aliases of the first.
+func (s *providerSettings) get(fromProfile string, keys ...string) string {
+	for _, key := range keys {
+		if s.configured(key) {
+			return s.d.Get(key).(string)
+		}
+	}
+	if fromProfile != "" {
+		return fromProfile
+	}
+	for _, key := range keys {
+		if v := s.d.Get(key).(string); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// token returns the permanent API token. A token source set in the provider block wins over
+// the profile, and the profile wins over the EC_PERMANENT_TOKEN, EC_TOKEN_FILE and
+// EC_TOKEN_COMMAND environment variables.
+func (s *providerSettings) token(ctx context.Context) (string, error) {
+	sources := []string{ProviderOptPermanentToken, ProviderOptTokenFile, ProviderOptTokenCommand}
+	for _, key := range sources {
+		if s.configured(key) {
+			return s.tokenFrom(ctx, key)
+		}
+	}
+
+	token, err := s.profile.ResolveToken(ctx)
+	if err != nil || token != "" {
+		return token, err
+	}
+
+	for _, key := range sources {
+		if s.d.Get(key).(string) != "" {
+			return s.tokenFrom(ctx, key)
+		}
+	}
+
+	return "", nil
+}
+
+func (s *providerSettings) tokenFrom(ctx context.Context, key string) (string, error) {
+	v := s.d.Get(key).(string)
+	switch key {
+	case ProviderOptTokenFile:
+		return profile.ReadTokenFile(v)
+	case ProviderOptTokenCommand:
+		return profile.RunTokenCommand(ctx, v)
+	case ProviderOptPermanentToken:
+		return v, nil
+	}
+
+	return "", fmt.Errorf("unknown token source %s", key)
+}
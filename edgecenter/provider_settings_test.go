@@ -0,0 +1,111 @@
+package edgecenter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/internal/versioncheck"
+)
+
+const testSharedConfig = `
+profiles:
+  staging:
+    token: profile-token
+    api_endpoint: https://api.staging.example
+    dns_api: https://dns.staging.example
+`
+
+// configureProvider runs ProviderConfigure the way Terraform does, so the raw
+// provider block is available to tell explicit values from environment defaults.
+func configureProvider(t *testing.T, attrs map[string]cty.Value) *Config {
+	t.Helper()
+	t.Setenv(versioncheck.EnvDisable, "1")
+
+	block := schema.InternalMap(ProviderSchema()).CoreConfigSchema()
+	vals := make(map[string]cty.Value)
+	for name, ty := range block.ImpliedType().AttributeTypes() {
+		vals[name] = cty.NullVal(ty)
+	}
+	for name, v := range attrs {
+		vals[name] = v
+	}
+
+	var config *Config
+	p := &schema.Provider{
+		Schema: ProviderSchema(),
+		ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			config, diags = ProviderConfigure(ctx, d, "1.9.8", "0.1.0")
+			return config, diags
+		},
+	}
+	raw := cty.ObjectVal(vals)
+	rc := terraform.NewResourceConfigShimmed(raw, block)
+	// The shim leaves the cty value empty, the gRPC server sets it from the request.
+	rc.CtyValue = raw
+	diags := p.Configure(context.Background(), rc)
+	if diags.HasError() {
+		t.Fatalf("configure: %v", diags)
+	}
+
+	return config
+}
+
+func writeSharedConfig(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(testSharedConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestProviderConfigureProfileOverridesEnvironment(t *testing.T) {
+	t.Setenv("EC_PERMANENT_TOKEN", "env-token")
+	t.Setenv("EC_API_ENDPOINT", "https://api.env.example")
+	t.Setenv("EC_SHARED_CONFIG_FILE", writeSharedConfig(t))
+	t.Setenv("EC_PROFILE", "staging")
+
+	config := configureProvider(t, nil)
+	if config.PermanentToken != "profile-token" {
+		t.Errorf("token = %q, want the profile token", config.PermanentToken)
+	}
+	if config.CloudBaseURL != "https://api.staging.example/cloud" {
+		t.Errorf("cloud api = %q, want the profile endpoint", config.CloudBaseURL)
+	}
+}
+
+func TestProviderConfigureProviderBlockOverridesProfile(t *testing.T) {
+	config := configureProvider(t, map[string]cty.Value{
+		ProviderOptProfile:           cty.StringVal("staging"),
+		ProviderOptSharedConfigFile:  cty.StringVal(writeSharedConfig(t)),
+		ProviderOptTokenCommand:      cty.StringVal("echo command-token"),
+		ProviderOptSingleAPIEndpoint: cty.StringVal("https://api.example.com"),
+	})
+	if config.PermanentToken != "command-token" {
+		t.Errorf("token = %q, want the token_command output", config.PermanentToken)
+	}
+	if config.CloudBaseURL != "https://api.example.com/cloud" {
+		t.Errorf("cloud api = %q, want the provider block endpoint", config.CloudBaseURL)
+	}
+}
+
+func TestProviderConfigureUnknownProfile(t *testing.T) {
+	t.Setenv(versioncheck.EnvDisable, "1")
+	d := schema.TestResourceDataRaw(t, ProviderSchema(), map[string]interface{}{
+		ProviderOptProfile:          "prod",
+		ProviderOptSharedConfigFile: writeSharedConfig(t),
+	})
+
+	if _, diags := ProviderConfigure(context.Background(), d, "1.9.8", "0.1.0"); !diags.HasError() {
+		t.Fatal("expected an error for a profile missing from the shared config file")
+	}
+}
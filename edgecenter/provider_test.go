@@ -2,9 +2,13 @@ package edgecenter
 
 import (
 	"context"
+	"path/filepath"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/Edge-Center/terraform-provider-edgecenter/internal/versioncheck"
 )
@@ -60,3 +64,198 @@ func TestProviderConfigureStaysQuietWhenCheckDisabled(t *testing.T) {
 		t.Errorf("diagnostics = %v, want none", diags)
 	}
 }
+
+func TestProviderConfigureDefaultProjectRegion(t *testing.T) {
+	t.Setenv(versioncheck.EnvDisable, "1")
+	d := schema.TestResourceDataRaw(t, ProviderSchema(), map[string]interface{}{
+		ProviderOptPermanentToken:    "token",
+		ProviderOptSingleAPIEndpoint: "https://api.example.com",
+		ProjectIDField:               42,
+		RegionNameField:              "Luxembourg",
+	})
+
+	config, diags := ProviderConfigure(context.Background(), d, "1.9.8", "0.1.0")
+	if diags.HasError() {
+		t.Fatalf("configure: %v", diags)
+	}
+	if config.DefaultProjectID != 42 || config.DefaultProjectName != "" {
+		t.Errorf("default project = (%d, %q), want (42, \"\")", config.DefaultProjectID, config.DefaultProjectName)
+	}
+	if config.DefaultRegionID != 0 || config.DefaultRegionName != "Luxembourg" {
+		t.Errorf("default region = (%d, %q), want (0, \"Luxembourg\")", config.DefaultRegionID, config.DefaultRegionName)
+	}
+}
+
+func TestApplyProviderDefault(t *testing.T) {
+	pair := map[string]*schema.Schema{
+		ProjectIDField:   {Type: schema.TypeInt, Optional: true, Computed: true},
+		ProjectNameField: {Type: schema.TypeString, Optional: true, Computed: true},
+	}
+
+	t.Run("resource value wins", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, pair, map[string]interface{}{ProjectNameField: "own"})
+		if applyProviderDefault(d, ProjectIDField, ProjectNameField, 42, "") {
+			t.Fatal("default applied over a configured project_name")
+		}
+		if got := d.Get(ProjectIDField).(int); got != 0 {
+			t.Errorf("project_id = %d, want 0", got)
+		}
+	})
+
+	t.Run("id default", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, pair, map[string]interface{}{})
+		if !applyProviderDefault(d, ProjectIDField, ProjectNameField, 42, "") {
+			t.Fatal("default not applied")
+		}
+		if got := d.Get(ProjectIDField).(int); got != 42 {
+			t.Errorf("project_id = %d, want 42", got)
+		}
+	})
+
+	t.Run("name default", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, pair, map[string]interface{}{})
+		if !applyProviderDefault(d, ProjectIDField, ProjectNameField, 0, "default") {
+			t.Fatal("default not applied")
+		}
+		if got := d.Get(ProjectNameField).(string); got != "default" {
+			t.Errorf("project_name = %q, want %q", got, "default")
+		}
+	})
+
+	t.Run("no pair in schema", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+			NameField: {Type: schema.TypeString, Optional: true},
+		}, map[string]interface{}{})
+		if applyProviderDefault(d, ProjectIDField, ProjectNameField, 42, "") {
+			t.Fatal("default applied to a schema without project_id")
+		}
+	})
+}
+
+func TestProviderScopeDiff(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		ProjectIDField:   {Type: schema.TypeInt, Optional: true, Computed: true},
+		ProjectNameField: {Type: schema.TypeString, Optional: true, Computed: true},
+	}}
+	WithProviderScopeDiff(r)
+
+	diff := func(t *testing.T, config map[string]interface{}, defaultProjectID int) *terraform.InstanceDiff {
+		t.Helper()
+		rawConfig := map[string]cty.Value{
+			ProjectIDField:   cty.NullVal(cty.Number),
+			ProjectNameField: cty.NullVal(cty.String),
+		}
+		if name, ok := config[ProjectNameField].(string); ok {
+			rawConfig[ProjectNameField] = cty.StringVal(name)
+		}
+		state := &terraform.InstanceState{
+			ID:         "1",
+			Attributes: map[string]string{"id": "1", ProjectIDField: "1", ProjectNameField: ""},
+			RawConfig:  cty.ObjectVal(rawConfig),
+		}
+		d, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), &Config{DefaultProjectID: defaultProjectID})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return d
+	}
+
+	t.Run("default changed", func(t *testing.T) {
+		d := diff(t, map[string]interface{}{}, 2)
+		// The replacement plans project_id as unknown, Create then applies the new default.
+		if d == nil || !d.Attributes[ProjectIDField].RequiresNew {
+			t.Fatalf("got %v, want the resource replaced", d)
+		}
+	})
+
+	t.Run("default unchanged", func(t *testing.T) {
+		if d := diff(t, map[string]interface{}{}, 1); d != nil && d.RequiresNew() {
+			t.Fatalf("got %v, want no replacement", d)
+		}
+	})
+
+	t.Run("resource value wins", func(t *testing.T) {
+		d := diff(t, map[string]interface{}{ProjectNameField: "own"}, 2)
+		if d != nil && d.Attributes[ProjectIDField] != nil && d.Attributes[ProjectIDField].RequiresNew {
+			t.Fatalf("got %v, want project_id left to the configured project_name", d)
+		}
+	})
+}
+
+func TestProviderConfigureSharesRetryingHTTPClient(t *testing.T) {
+	t.Setenv(versioncheck.EnvDisable, "1")
+	d := schema.TestResourceDataRaw(t, ProviderSchema(), map[string]interface{}{
+		ProviderOptPermanentToken:    "token",
+		ProviderOptSingleAPIEndpoint: "https://api.example.com",
+		ProviderOptMaxRetries:        2,
+		ProviderOptRetryMaxBackoff:   "5s",
+		ProviderOptRequestsPerSecond: 10.0,
+	})
+
+	config, diags := ProviderConfigure(context.Background(), d, "1.9.8", "0.1.0")
+	if diags.HasError() {
+		t.Fatalf("configure: %v", diags)
+	}
+	if config.HTTPClient == nil || config.HTTPClient.Transport == nil {
+		t.Fatal("shared HTTP client is not configured")
+	}
+	if config.Provider.HTTPClient.Transport == nil {
+		t.Error("cloud v1 client does not use the shared transport")
+	}
+	if _, ok := config.StorageClient.(*storageHTTPClient); !ok {
+		t.Error("storage client does not use the shared transport")
+	}
+}
+
+func TestProviderConfigureRejectsUnreadableCABundle(t *testing.T) {
+	t.Setenv(versioncheck.EnvDisable, "1")
+	d := schema.TestResourceDataRaw(t, ProviderSchema(), map[string]interface{}{
+		ProviderOptPermanentToken:    "token",
+		ProviderOptSingleAPIEndpoint: "https://api.example.com",
+		ProviderOptCABundleFile:      filepath.Join(t.TempDir(), "missing.pem"),
+	})
+
+	_, diags := ProviderConfigure(context.Background(), d, "1.9.8", "0.1.0")
+	if !diags.HasError() {
+		t.Fatal("expected an error for a missing CA bundle")
+	}
+}
+
+func TestProviderConfigureDefaultMetadata(t *testing.T) {
+	t.Setenv(versioncheck.EnvDisable, "1")
+	d := schema.TestResourceDataRaw(t, ProviderSchema(), map[string]interface{}{
+		ProviderOptPermanentToken:    "token",
+		ProviderOptSingleAPIEndpoint: "https://api.example.com",
+		DefaultMetadataField:         map[string]interface{}{"owner": "platform", "env": "prod"},
+	})
+
+	config, diags := ProviderConfigure(context.Background(), d, "1.9.8", "0.1.0")
+	if diags.HasError() {
+		t.Fatalf("configure: %v", diags)
+	}
+	want := map[string]string{"owner": "platform", "env": "prod"}
+	if !reflect.DeepEqual(config.DefaultMetadata, want) {
+		t.Errorf("default metadata = %v, want %v", config.DefaultMetadata, want)
+	}
+}
+
+func TestProviderConfigureQuotaPreflight(t *testing.T) {
+	t.Setenv(versioncheck.EnvDisable, "1")
+	d := schema.TestResourceDataRaw(t, ProviderSchema(), map[string]interface{}{
+		ProviderOptPermanentToken:    "token",
+		ProviderOptSingleAPIEndpoint: "https://api.example.com",
+		ProviderOptQuotaPreflight:    true,
+	})
+
+	config, diags := ProviderConfigure(context.Background(), d, "1.9.8", "0.1.0")
+	if diags.HasError() {
+		t.Fatalf("configure: %v", diags)
+	}
+	if !config.QuotaPreflight {
+		t.Error("quota preflight is not enabled")
+	}
+	if config.quotas == nil {
+		t.Error("quota ledger is not initialized")
+	}
+}
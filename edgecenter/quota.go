@@ -0,0 +1,227 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go"
+	"github.com/Edge-Center/edgecentercloud-go/edgecenter"
+	"github.com/Edge-Center/edgecentercloud-go/edgecenter/quota/v2/quotas"
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	QuotaPoint = "quotas"
+
+	quotaLimitSuffix = "_limit"
+	quotaUsageSuffix = "_usage"
+	quotaRegionIDKey = "region_id"
+
+	QuotaVMCount           = "vm_count"
+	QuotaCPUCount          = "cpu_count"
+	QuotaRAM               = "ram"
+	QuotaVolumeCount       = "volume_count"
+	QuotaVolumeSize        = "volume_size"
+	QuotaFloatingCount     = "floating_count"
+	QuotaLoadbalancerCount = "loadbalancer_count"
+)
+
+// QuotaDemand is the amount of every quota a plan consumes, keyed by the quota name,
+// e.g. "cpu_count". Negative values free the quota.
+type QuotaDemand map[string]int
+
+// Sub returns the demand of d on top of the demand old that is already in use.
+func (d QuotaDemand) Sub(old QuotaDemand) QuotaDemand {
+	res := make(QuotaDemand, len(d))
+	for name, v := range d {
+		res[name] = v
+	}
+	for name, v := range old {
+		res[name] -= v
+	}
+
+	return res
+}
+
+// regionQuota holds the limit and the usage of every quota of the client in a region.
+type regionQuota struct {
+	limits map[string]int
+	usage  map[string]int
+}
+
+// remaining returns how much of the quota is left, and false when the quota is unlimited.
+func (q regionQuota) remaining(name string) (int, bool) {
+	limit, ok := q.limits[name]
+	if !ok || limit < 0 {
+		return 0, false
+	}
+
+	return limit - q.usage[name], true
+}
+
+// add splits the flat quota map of the API into limits and usage keyed by the quota name.
+func (q *regionQuota) add(raw quotas.Quota) {
+	for key, v := range raw {
+		switch {
+		case strings.HasSuffix(key, quotaLimitSuffix):
+			q.limits[strings.TrimSuffix(key, quotaLimitSuffix)] = v
+		case strings.HasSuffix(key, quotaUsageSuffix):
+			q.usage[strings.TrimSuffix(key, quotaUsageSuffix)] = v
+		}
+	}
+}
+
+// fetchRegionQuota returns the global quotas of the client combined with its quotas in the region.
+func fetchRegionQuota(config *Config, regionID int) (regionQuota, error) {
+	client, err := edgecenter.ClientServiceFromProvider(config.Provider, edgecloud.EndpointOpts{
+		Name:    QuotaPoint,
+		Version: VersionPointV2,
+	})
+	if err != nil {
+		return regionQuota{}, err
+	}
+
+	combined, err := quotas.ListCombined(client, nil).Extract()
+	if err != nil {
+		return regionQuota{}, fmt.Errorf("cannot get quotas: %w", err)
+	}
+
+	q := regionQuota{limits: make(map[string]int), usage: make(map[string]int)}
+	q.add(combined.GlobalQuotas)
+	for _, regional := range combined.RegionalQuotas {
+		if regional[quotaRegionIDKey] == regionID {
+			q.add(regional)
+			return q, nil
+		}
+	}
+
+	return regionQuota{}, fmt.Errorf("quotas of region %d not found", regionID)
+}
+
+// quotaLedger sums the quota demand of all resources planned by a provider instance.
+// The quota of a region is fetched once, on the first demand in it, so resources created
+// while the plan is applied are not counted twice: once in the usage and once in the demand.
+type quotaLedger struct {
+	mu      sync.Mutex
+	quotas  map[int]regionQuota
+	planned map[int]QuotaDemand
+}
+
+func newQuotaLedger() *quotaLedger {
+	return &quotaLedger{
+		quotas:  make(map[int]regionQuota),
+		planned: make(map[int]QuotaDemand),
+	}
+}
+
+// reserve adds demand to the demand planned in the region, or returns an error
+// and reserves nothing when the total exceeds the remaining quota.
+// Negative demand is ignored: a plan is not reordered, so the freed quota may not be
+// available yet when another resource needs it. A nil ledger reserves nothing.
+func (l *quotaLedger) reserve(regionID int, demand QuotaDemand, fetch func() (regionQuota, error)) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	q, ok := l.quotas[regionID]
+	if !ok {
+		var err error
+		q, err = fetch()
+		if err != nil {
+			return err
+		}
+		l.quotas[regionID] = q
+	}
+
+	planned := make(QuotaDemand, len(demand))
+	var exceeded []string
+	for name, v := range demand {
+		if v <= 0 {
+			continue
+		}
+		planned[name] = l.planned[regionID][name] + v
+		if remaining, limited := q.remaining(name); limited && planned[name] > remaining {
+			exceeded = append(exceeded, fmt.Sprintf("%s: planned %d, remaining %d of %d", name, planned[name], remaining, q.limits[name]))
+		}
+	}
+	if len(exceeded) > 0 {
+		sort.Strings(exceeded)
+		return fmt.Errorf("the plan exceeds the quota in region %d: %s", regionID, strings.Join(exceeded, "; "))
+	}
+
+	if l.planned[regionID] == nil {
+		l.planned[regionID] = make(QuotaDemand)
+	}
+	for name, v := range planned {
+		l.planned[regionID][name] = v
+	}
+
+	return nil
+}
+
+// CheckQuotaDemand reserves the quota demand of the planned resource in its region and returns an error
+// when the demand of all resources planned so far exceeds the remaining quota.
+// It does nothing unless the quota_preflight provider option is set, or while the project or the region
+// of the resource is not known. demand may return nil when the resource consumes no quota.
+func CheckQuotaDemand(
+	ctx context.Context,
+	d *schema.ResourceDiff,
+	m interface{},
+	demand func(ctx context.Context, client *edgecloudV2.Client) (QuotaDemand, error),
+) error {
+	config, ok := m.(*Config)
+	if !ok || !config.QuotaPreflight || config.quotas == nil {
+		return nil
+	}
+
+	client, ok, err := planCloudClient(d, config)
+	if err != nil || !ok {
+		return err
+	}
+	regionID := client.Region
+
+	planned, err := demand(ctx, client)
+	if err != nil {
+		return fmt.Errorf("cannot compute the quota demand: %w", err)
+	}
+	if len(planned) == 0 {
+		return nil
+	}
+	log.Printf("[DEBUG] Quota demand in region %d: %v", regionID, planned)
+
+	return config.quotas.reserve(regionID, planned, func() (regionQuota, error) {
+		return fetchRegionQuota(config, regionID)
+	})
+}
+
+// FlavorQuotaDemand returns the quota consumed by count instances of the flavor.
+func FlavorQuotaDemand(ctx context.Context, client *edgecloudV2.Client, flavorID string, count int) (QuotaDemand, error) {
+	if flavorID == "" || count == 0 {
+		return QuotaDemand{}, nil
+	}
+
+	flavors, _, err := client.Flavors.List(ctx, &edgecloudV2.FlavorListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch instance flavors: %w", err)
+	}
+	for _, flavor := range flavors {
+		if flavor.FlavorID == flavorID {
+			return QuotaDemand{
+				QuotaVMCount:  count,
+				QuotaCPUCount: flavor.VCPUS * count,
+				QuotaRAM:      flavor.RAM * count,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("flavor %s not found", flavorID)
+}
@@ -0,0 +1,109 @@
+package edgecenter
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testRegionQuota() regionQuota {
+	return regionQuota{
+		limits: map[string]int{QuotaCPUCount: 10, QuotaRAM: 16384, QuotaVMCount: -1},
+		usage:  map[string]int{QuotaCPUCount: 4, QuotaRAM: 4096, QuotaVMCount: 3},
+	}
+}
+
+func TestQuotaLedgerReserveSumsDemand(t *testing.T) {
+	l := newQuotaLedger()
+	calls := 0
+	fetch := func() (regionQuota, error) {
+		calls++
+		return testRegionQuota(), nil
+	}
+	demand := QuotaDemand{QuotaCPUCount: 2, QuotaRAM: 4096, QuotaVMCount: 1}
+
+	for i := 0; i < 3; i++ {
+		if err := l.reserve(1, demand, fetch); err != nil {
+			t.Fatalf("reserve %d: %v", i, err)
+		}
+	}
+	err := l.reserve(1, demand, fetch)
+	if err == nil {
+		t.Fatal("expected the fourth reservation to exceed the quota")
+	}
+	for _, want := range []string{"region 1", "cpu_count: planned 8, remaining 6 of 10", "ram: planned 16384, remaining 12288 of 16384"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("fetch called %d times, want 1", calls)
+	}
+
+	want := QuotaDemand{QuotaCPUCount: 6, QuotaRAM: 12288, QuotaVMCount: 3}
+	if !reflect.DeepEqual(l.planned[1], want) {
+		t.Errorf("planned = %v, want %v", l.planned[1], want)
+	}
+}
+
+func TestQuotaLedgerReserveIgnoresFreedQuota(t *testing.T) {
+	l := newQuotaLedger()
+	fetch := func() (regionQuota, error) { return testRegionQuota(), nil }
+
+	if err := l.reserve(1, QuotaDemand{QuotaCPUCount: -4}, fetch); err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	if err := l.reserve(1, QuotaDemand{QuotaCPUCount: 8}, fetch); err == nil {
+		t.Error("expected freed quota not to be available to the plan")
+	}
+}
+
+func TestQuotaLedgerReserveIsPerRegion(t *testing.T) {
+	l := newQuotaLedger()
+	fetch := func() (regionQuota, error) { return testRegionQuota(), nil }
+
+	for _, regionID := range []int{1, 2} {
+		if err := l.reserve(regionID, QuotaDemand{QuotaCPUCount: 6}, fetch); err != nil {
+			t.Errorf("reserve in region %d: %v", regionID, err)
+		}
+	}
+}
+
+func TestQuotaLedgerReserveDoesNotCacheFetchErrors(t *testing.T) {
+	l := newQuotaLedger()
+	fail := true
+	fetch := func() (regionQuota, error) {
+		if fail {
+			return regionQuota{}, errors.New("unavailable")
+		}
+		return testRegionQuota(), nil
+	}
+
+	if err := l.reserve(1, QuotaDemand{QuotaCPUCount: 1}, fetch); err == nil {
+		t.Fatal("expected the fetch error")
+	}
+	fail = false
+	if err := l.reserve(1, QuotaDemand{QuotaCPUCount: 1}, fetch); err != nil {
+		t.Errorf("reserve after a failed fetch: %v", err)
+	}
+}
+
+func TestQuotaLedgerNilReservesNothing(t *testing.T) {
+	var l *quotaLedger
+	err := l.reserve(1, QuotaDemand{QuotaCPUCount: 1}, func() (regionQuota, error) {
+		t.Fatal("fetch called on a nil ledger")
+		return regionQuota{}, nil
+	})
+	if err != nil {
+		t.Errorf("reserve: %v", err)
+	}
+}
+
+func TestQuotaDemandSub(t *testing.T) {
+	got := QuotaDemand{QuotaCPUCount: 4, QuotaRAM: 8192}.Sub(QuotaDemand{QuotaCPUCount: 2, QuotaRAM: 8192, QuotaVMCount: 1})
+	want := QuotaDemand{QuotaCPUCount: 2, QuotaRAM: 0, QuotaVMCount: -1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sub = %v, want %v", got, want)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"reflect"
 	"sort"
 	"strconv"
 	"time"
@@ -34,6 +33,7 @@ func resourceBmInstance() *schema.Resource {
 		ReadContext:   resourceBmInstanceRead,
 		UpdateContext: resourceBmInstanceUpdate,
 		DeleteContext: resourceBmInstanceDelete,
+		CustomizeDiff: instanceMetadata.customizeDiff,
 		Description:   "Represent baremetal instance",
 		Timeouts: &schema.ResourceTimeout{
 			Create: &bmCreateTimeout,
@@ -54,32 +54,32 @@ func resourceBmInstance() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Computed:      true,
+				Optional:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Computed:      true,
+				Optional:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"flavor_id": {
 				Type:     schema.TypeString,
@@ -215,6 +215,7 @@ func resourceBmInstance() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			MetadataAllField: metadataAllSchema(),
 			"app_config": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -339,24 +340,8 @@ func resourceBmInstanceCreate(ctx context.Context, d *schema.ResourceData, m int
 		createRequest.NameTemplates = []string{nameTemplate.(string)}
 	}
 
-	if metadata, ok := d.GetOk("metadata"); ok {
-		if len(metadata.([]interface{})) > 0 {
-			metadataKV, err := extractKeyValueV2(metadata.([]interface{}))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			metadata, err := MapInterfaceToMapString(metadataKV)
-			if err != nil {
-				diag.FromErr(err)
-			}
-			createRequest.Metadata = *metadata
-		}
-	} else if metadataRaw, ok := d.GetOk("metadata_map"); ok {
-		metadata, err := MapInterfaceToMapString(metadataRaw)
-		if err != nil {
-			diag.FromErr(err)
-		}
-		createRequest.Metadata = *metadata
+	if metadata := instanceMetadata.merged(d, m); len(metadata) > 0 {
+		createRequest.Metadata = metadata
 	}
 
 	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Instances.BareMetalCreateInstance, &createRequest, clientV2, bmCreateTimeout)
@@ -533,6 +518,14 @@ func resourceBmInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 
+	apiMetadata, err := prepareMetadataFromAPI(ctx, clientV2, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := instanceMetadata.setManagedFromAPI(d, m, apiMetadata); err != nil {
+		return diag.FromErr(err)
+	}
+
 	addresses := []map[string][]map[string]string{}
 	for _, data := range instance.Addresses {
 		d := map[string][]map[string]string{}
@@ -579,40 +572,9 @@ func resourceBmInstanceUpdate(ctx context.Context, d *schema.ResourceData, m int
 		}
 	}
 
-	if d.HasChange("metadata") {
-		omd, nmd := d.GetChange("metadata")
-		if len(omd.([]interface{})) > 0 {
-			for _, data := range omd.([]interface{}) {
-				d := data.(map[string]interface{})
-				k := d["key"].(string)
-				_, err = clientV2.Instances.MetadataDeleteItem(ctx, instanceID, &edgecloudV2.MetadataItemOptions{Key: k})
-				if err != nil {
-					return diag.Errorf("cannot delete metadata key: %s. Error: %s", k, err)
-				}
-			}
-		}
-		if len(nmd.([]interface{})) > 0 {
-			MetaData := make(edgecloudV2.Metadata)
-			for _, data := range nmd.([]interface{}) {
-				d := data.(map[string]interface{})
-				MetaData[d["key"].(string)] = d["value"].(string)
-			}
-			_, err = clientV2.Instances.MetadataCreate(ctx, instanceID, &MetaData)
-			if err != nil {
-				return diag.Errorf("cannot create metadata. Error: %s", err)
-			}
-		}
-	} else if d.HasChange("metadata_map") {
-		omd, nmd := d.GetChange("metadata_map")
-		if !reflect.DeepEqual(omd, nmd) {
-			MetaData := make(edgecloudV2.Metadata)
-			for k, v := range nmd.(map[string]interface{}) {
-				MetaData[k] = v.(string)
-			}
-			_, err = clientV2.Instances.MetadataCreate(ctx, instanceID, &MetaData)
-			if err != nil {
-				return diag.Errorf("cannot create metadata. Error: %s", err)
-			}
+	if instanceMetadata.hasChange(d) {
+		if err := updateInstanceMetadata(ctx, clientV2, instanceID, d, m, instanceMetadata); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
@@ -30,8 +31,13 @@ func resourceFloatingIP() *schema.Resource {
 		ReadContext:   resourceFloatingIPRead,
 		UpdateContext: resourceFloatingIPUpdate,
 		DeleteContext: resourceFloatingIPDelete,
+		CustomizeDiff: customdiff.All(cloudMetadata.customizeDiff, floatingIPQuotaDiff),
 		Description: `A floating IP is a static IP address that can be associated with one of your instances or loadbalancers,
 allowing it to have a static public IP address. The floating IP can be re-associated to any other instance in the same datacenter.`,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(FloatingIPCreateTimeout),
+			Delete: schema.DefaultTimeout(FloatingIPDeleteTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, fipID, err := ImportStringParser(d.Id())
@@ -48,28 +54,32 @@ allowing it to have a static public IP address. The floating IP can be re-associ
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"floating_ip_address": {
 				Type:        schema.TypeString,
@@ -79,7 +89,7 @@ allowing it to have a static public IP address. The floating IP can be re-associ
 			"port_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The ID (uuid) of the network port that the floating IP is associated with.",
+				Description: "The ID (uuid) of the network port that the floating IP is associated with. Leave it unset when the association is managed by 'edgecenter_floatingip_association'.",
 			},
 			"instance_port_id": {
 				Type:        schema.TypeString,
@@ -150,6 +160,7 @@ allowing it to have a static public IP address. The floating IP can be re-associ
 					Type: schema.TypeString,
 				},
 			},
+			MetadataAllField: metadataAllSchema(),
 			"metadata_read_only": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -189,15 +200,11 @@ func resourceFloatingIPCreate(ctx context.Context, d *schema.ResourceData, m int
 		FixedIPAddress: net.ParseIP(d.Get("fixed_ip_address").(string)),
 	}
 
-	if metadataRaw, ok := d.GetOk("metadata_map"); ok {
-		meta, err := MapInterfaceToMapString(metadataRaw)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		opts.Metadata = *meta
+	if metadata := cloudMetadata.merged(d, m); len(metadata) > 0 {
+		opts.Metadata = metadata
 	}
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Floatingips.Create, opts, clientV2, FloatingIPCreateTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Floatingips.Create, opts, clientV2, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -245,21 +252,28 @@ func resourceFloatingIPRead(ctx context.Context, d *schema.ResourceData, m inter
 	d.Set("project_id", floatingIP.ProjectID)
 	d.Set("region_id", floatingIP.RegionID)
 	d.Set("status", floatingIP.Status)
+	instancePortID, loadBalancerPortID := "", ""
 	if floatingIP.Instance.ID != "" {
-		d.Set("instance_id_attached_to", floatingIP.Instance.ID)
-		d.Set("instance_port_id", floatingIP.PortID)
+		instancePortID = floatingIP.PortID
 	}
 	if floatingIP.Loadbalancer.ID != "" {
-		d.Set("load_balancers_id_attached_to", floatingIP.Loadbalancer.ID)
-		d.Set("load_balancers_port_id", floatingIP.PortID)
+		loadBalancerPortID = floatingIP.PortID
+	}
+	d.Set("instance_id_attached_to", floatingIP.Instance.ID)
+	d.Set("instance_port_id", instancePortID)
+	d.Set("load_balancers_id_attached_to", floatingIP.Loadbalancer.ID)
+	d.Set("load_balancers_port_id", loadBalancerPortID)
+	// The association may be managed by edgecenter_floatingip_association instead,
+	// so port_id is only refreshed when this resource sets it.
+	if d.Get("port_id").(string) != "" {
+		d.Set("port_id", floatingIP.PortID)
 	}
-	d.Set("port_id", floatingIP.PortID)
 	d.Set("router_id", floatingIP.RouterID)
 	d.Set("floating_ip_address", floatingIP.FloatingIPAddress)
 
 	metadataMap, metadataReadOnly := PrepareMetadata(floatingIP.Metadata)
 
-	if err = d.Set("metadata_map", metadataMap); err != nil {
+	if err = cloudMetadata.setFromAPI(d, m, metadataMap); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -280,7 +294,7 @@ func resourceFloatingIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges("fixed_ip_address", "port_id") {
+	if d.HasChanges("fixed_ip_address", "port_id") && !isFloatingIPAssignedToPort(d) {
 		oldFixedIP, newFixedIP := d.GetChange("fixed_ip_address")
 		oldPortID, newPortID := d.GetChange("port_id")
 		if oldPortID.(string) != "" || oldFixedIP.(string) != "" {
@@ -307,15 +321,8 @@ func resourceFloatingIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 		d.Set("last_updated", time.Now().Format(time.RFC850))
 	}
 
-	if d.HasChange("metadata_map") {
-		_, nmd := d.GetChange("metadata_map")
-
-		meta, err := MapInterfaceToMapString(nmd.(map[string]interface{}))
-		if err != nil {
-			return diag.Errorf("cannot get metadata. Error: %s", err)
-		}
-
-		metaChanged := edgecloudV2.Metadata(*meta)
+	if cloudMetadata.hasChange(d) {
+		metaChanged := edgecloudV2.Metadata(cloudMetadata.merged(d, m))
 		_, err = clientV2.Floatingips.MetadataUpdate(ctx, d.Id(), &metaChanged)
 		if err != nil {
 			return diag.Errorf("cannot update metadata. Error: %s", err)
@@ -325,6 +332,18 @@ func resourceFloatingIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 	return resourceFloatingIPRead(ctx, d, m)
 }
 
+// isFloatingIPAssignedToPort reports whether port_id is set on a floating ip that is already
+// assigned to that port, e.g. after import or by edgecenter_floatingip_association.
+// Reassigning it would only drop the traffic for a while.
+func isFloatingIPAssignedToPort(d *schema.ResourceData) bool {
+	oldPortID, newPortID := d.GetChange("port_id")
+	if oldPortID.(string) != "" || newPortID.(string) == "" || d.HasChange("fixed_ip_address") {
+		return false
+	}
+
+	return newPortID == d.Get("instance_port_id") || newPortID == d.Get("load_balancers_port_id")
+}
+
 func isIgnorableFloatingIPUnassignError(err error, resp *edgecloudV2.Response) bool {
 	if err == nil {
 		return false
@@ -354,7 +373,7 @@ func resourceFloatingIPDelete(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	taskID := results.Tasks[0]
-	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, FloatingIPDeleteTimeout)
+	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -368,3 +387,14 @@ func resourceFloatingIPDelete(ctx context.Context, d *schema.ResourceData, m int
 
 	return diags
 }
+
+// floatingIPQuotaDiff checks the quota demand of a new floating IP.
+func floatingIPQuotaDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	return CheckQuotaDemand(ctx, d, m, func(context.Context, *edgecloudV2.Client) (QuotaDemand, error) {
+		return QuotaDemand{QuotaFloatingCount: 1}, nil
+	})
+}
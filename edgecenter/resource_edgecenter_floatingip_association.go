@@ -0,0 +1,256 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func resourceFloatingIPAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFloatingIPAssociationCreate,
+		ReadContext:   resourceFloatingIPAssociationRead,
+		UpdateContext: resourceFloatingIPAssociationUpdate,
+		DeleteContext: resourceFloatingIPAssociationDelete,
+		CustomizeDiff: customFloatingIPAssociationDiff,
+		Description: `Associates a floating IP with the network port of an instance or a load balancer VIP.
+Changing the port re-targets the floating IP in place, the floating IP itself is kept.
+Do not set 'port_id' on the 'edgecenter_floatingip' resource of the same floating IP.`,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, fipID, err := ImportStringParser(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectID)
+				d.Set("region_id", regionID)
+				d.Set("floating_ip_id", fipID)
+				d.SetId(fipID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
+			},
+			"project_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
+			},
+			"region_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
+			},
+			"floating_ip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID (uuid) of the floating IP.",
+			},
+			"port_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID (uuid) of the network port of the instance or the load balancer VIP to associate the floating IP with.",
+			},
+			"fixed_ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The fixed IP address of the port to associate the floating IP with. Required when the port has several addresses. When it is not set, the address is chosen by the cloud again each time the port changes.",
+				ValidateDiagFunc: func(val interface{}, key cty.Path) diag.Diagnostics {
+					v := val.(string)
+					ip := net.ParseIP(v)
+					if ip != nil {
+						return diag.Diagnostics{}
+					}
+
+					return diag.FromErr(fmt.Errorf("%q must be a valid ip, got: %s", key, v))
+				},
+			},
+			"floating_ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The floating IP address.",
+			},
+		},
+	}
+}
+
+// customFloatingIPAssociationDiff plans a new fixed IP address when the port changes and the address
+// is not configured, the address in the state belongs to the previous port.
+func customFloatingIPAssociationDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("port_id") {
+		return nil
+	}
+	if raw := d.GetRawConfig(); !raw.IsNull() && raw.IsKnown() && !raw.GetAttr("fixed_ip_address").IsNull() {
+		return nil
+	}
+	if err := d.SetNewComputed("fixed_ip_address"); err != nil {
+		return fmt.Errorf("mark fixed_ip_address as computed after port_id change: %w", err)
+	}
+
+	return nil
+}
+
+// assignFloatingIP sends the fixed IP address only when it is configured, otherwise the cloud picks
+// the address of the port.
+func assignFloatingIP(ctx context.Context, clientV2 *edgecloudV2.Client, d *schema.ResourceData) error {
+	opts := &edgecloudV2.AssignFloatingIPRequest{
+		PortID: d.Get("port_id").(string),
+	}
+	if raw := d.GetRawConfig(); !raw.IsNull() && raw.IsKnown() {
+		if fixedIP := raw.GetAttr("fixed_ip_address"); !fixedIP.IsNull() && fixedIP.IsKnown() {
+			opts.FixedIPAddress = net.ParseIP(fixedIP.AsString())
+		}
+	}
+
+	if _, _, err := clientV2.Floatingips.Assign(ctx, d.Get("floating_ip_id").(string), opts); err != nil {
+		return fmt.Errorf("cannot assign floating ip to port %s: %w", opts.PortID, err)
+	}
+
+	return nil
+}
+
+func resourceFloatingIPAssociationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start FloatingIPAssociation creating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("region_id", clientV2.Region)
+	d.Set("project_id", clientV2.Project)
+
+	if err := assignFloatingIP(ctx, clientV2, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("floating_ip_id").(string))
+	log.Printf("[DEBUG] Finish FloatingIPAssociation creating (%s)", d.Id())
+
+	return resourceFloatingIPAssociationRead(ctx, d, m)
+}
+
+func resourceFloatingIPAssociationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start FloatingIPAssociation reading")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	floatingIP, resp, err := clientV2.Floatingips.Get(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing floating ip association %s because the floating ip doesn't exist anymore", d.Id())
+			d.SetId("")
+
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if floatingIP.PortID == "" {
+		log.Printf("[WARN] Removing floating ip association %s because the floating ip is not assigned anymore", d.Id())
+		d.SetId("")
+
+		return diags
+	}
+
+	d.Set("floating_ip_id", floatingIP.ID)
+	d.Set("port_id", floatingIP.PortID)
+	if floatingIP.FixedIPAddress != nil {
+		d.Set("fixed_ip_address", floatingIP.FixedIPAddress.String())
+	} else {
+		d.Set("fixed_ip_address", "")
+	}
+	d.Set("floating_ip_address", floatingIP.FloatingIPAddress)
+
+	fields := []string{"project_id", "region_id"}
+	revertState(d, &fields)
+
+	log.Println("[DEBUG] Finish FloatingIPAssociation reading")
+
+	return diags
+}
+
+func resourceFloatingIPAssociationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start FloatingIPAssociation updating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("port_id", "fixed_ip_address") {
+		_, resp, err := clientV2.Floatingips.UnAssign(ctx, d.Id())
+		if err != nil {
+			if !isIgnorableFloatingIPUnassignError(err, resp) {
+				return diag.FromErr(err)
+			}
+			log.Printf("[DEBUG] FloatingIP %s is already detached from its previous port, continuing with reassignment: %s", d.Id(), err)
+		}
+
+		if err := assignFloatingIP(ctx, clientV2, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Println("[DEBUG] Finish FloatingIPAssociation updating")
+
+	return resourceFloatingIPAssociationRead(ctx, d, m)
+}
+
+func resourceFloatingIPAssociationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start FloatingIPAssociation deleting")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, resp, err := clientV2.Floatingips.UnAssign(ctx, d.Id())
+	if err != nil && !isIgnorableFloatingIPUnassignError(err, resp) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	log.Printf("[DEBUG] Finish of FloatingIPAssociation deleting")
+
+	return diags
+}
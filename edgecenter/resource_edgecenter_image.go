@@ -0,0 +1,406 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+const (
+	ImageCreateTimeout = 3600 * time.Second
+	ImageDeleteTimeout = 1200 * time.Second
+)
+
+func resourceImage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImageCreate,
+		ReadContext:   resourceImageRead,
+		UpdateContext: resourceImageUpdate,
+		DeleteContext: resourceImageDelete,
+		CustomizeDiff: resourceImageCustomizeDiff,
+		Description: `Represent a custom image of the project. The image is uploaded from an HTTP(S) URL or created from an existing volume.
+The format of an uploaded image is detected by the cloud and exposed as 'disk_format'.`,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ImageCreateTimeout),
+			Delete: schema.DefaultTimeout(ImageDeleteTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, imageID, err := ImportStringParser(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectID)
+				d.Set("region_id", regionID)
+				d.SetId(imageID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
+			},
+			"project_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
+			},
+			"region_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the image.",
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "(ForceNew) The HTTP(S) URL to upload the image from. Either 'url' or 'volume_id' must be specified.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				ExactlyOneOf: []string{"url", "volume_id"},
+			},
+			"volume_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "(ForceNew) The uuid of the volume to create the image from. Either 'url' or 'volume_id' must be specified.",
+			},
+			"os_distro": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "(ForceNew) The OS distribution of the uploaded image, e.g. 'ubuntu'. Only used with 'url'.",
+				ConflictsWith: []string{"volume_id"},
+			},
+			"os_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "(ForceNew) The OS version of the uploaded image, e.g. '22.04'. Only used with 'url'.",
+				ConflictsWith: []string{"volume_id"},
+			},
+			"os_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(edgecloudV2.OsLinux),
+				Description:  fmt.Sprintf("The OS type of the image. Available values are '%s', '%s'.", edgecloudV2.OsLinux, edgecloudV2.OsWindows),
+				ValidateFunc: validation.StringInSlice([]string{string(edgecloudV2.OsLinux), string(edgecloudV2.OsWindows)}, false),
+			},
+			"ssh_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(edgecloudV2.SSHKeyAllow),
+				Description:  fmt.Sprintf("Whether an SSH key is used for the instances of the image. Available values are '%s', '%s', '%s'.", edgecloudV2.SSHKeyAllow, edgecloudV2.SSHKeyDeny, edgecloudV2.SSHKeyRequired),
+				ValidateFunc: validation.StringInSlice([]string{string(edgecloudV2.SSHKeyAllow), string(edgecloudV2.SSHKeyDeny), string(edgecloudV2.SSHKeyRequired)}, false),
+			},
+			"hw_machine_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(edgecloudV2.HwMachineQ35),
+				Description:  fmt.Sprintf("The virtual chipset type of the instances of the image. Available values are '%s', '%s'.", edgecloudV2.HwMachineI440, edgecloudV2.HwMachineQ35),
+				ValidateFunc: validation.StringInSlice([]string{string(edgecloudV2.HwMachineI440), string(edgecloudV2.HwMachineQ35)}, false),
+			},
+			"hw_firmware_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(edgecloudV2.HwFirmwareBIOS),
+				Description:  fmt.Sprintf("The firmware type of the instances of the image. Available values are '%s', '%s'.", edgecloudV2.HwFirmwareBIOS, edgecloudV2.HwFirmwareUEFI),
+				ValidateFunc: validation.StringInSlice([]string{string(edgecloudV2.HwFirmwareBIOS), string(edgecloudV2.HwFirmwareUEFI)}, false),
+			},
+			"is_baremetal": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true if the image is for baremetal servers.",
+			},
+			"cow_format": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				Description:   "(ForceNew) Set to true to forbid the deletion of the image while volumes created from it exist. Only used with 'url'.",
+				ConflictsWith: []string{"volume_id"},
+			},
+			"metadata_map": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "(ForceNew) A map containing metadata, for example tags.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			MetadataAllField: metadataAllSchema(),
+			"metadata_read_only": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `A list of read-only metadata items, e.g. tags.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"read_only": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"min_disk": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum disk space (in GB) required to launch an instance using this image.",
+			},
+			"min_ram": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum VM RAM (in MB) required to launch an instance using this image.",
+			},
+			"disk_format": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The disk format of the image, e.g. 'qcow2' or 'raw'.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the image in bytes.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the image.",
+			},
+		},
+	}
+}
+
+// resourceImageCustomizeDiff plans metadata_all. The metadata of an image can't be changed,
+// so a change of the provider defaults replaces the image too.
+func resourceImageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := cloudMetadata.customizeDiff(ctx, d, m); err != nil {
+		return err
+	}
+	if d.Id() != "" && d.HasChange(MetadataAllField) {
+		return d.ForceNew(MetadataAllField)
+	}
+
+	return nil
+}
+
+func resourceImageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Image creating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("region_id", clientV2.Region)
+	d.Set("project_id", clientV2.Project)
+
+	isBaremetal := d.Get("is_baremetal").(bool)
+	metadata := cloudMetadata.merged(d, m)
+
+	var imageIDs []string
+	if url := d.Get("url").(string); url != "" {
+		opts := &edgecloudV2.ImageUploadRequest{
+			Name:           d.Get("name").(string),
+			URL:            url,
+			OsDistro:       d.Get("os_distro").(string),
+			OsVersion:      d.Get("os_version").(string),
+			OSType:         edgecloudV2.OSType(d.Get("os_type").(string)),
+			SSHKey:         edgecloudV2.SSHKeyType(d.Get("ssh_key").(string)),
+			HwMachineType:  edgecloudV2.HwMachineType(d.Get("hw_machine_type").(string)),
+			HwFirmwareType: edgecloudV2.HwFirmwareType(d.Get("hw_firmware_type").(string)),
+			IsBaremetal:    &isBaremetal,
+			CowFormat:      d.Get("cow_format").(bool),
+		}
+		if len(metadata) > 0 {
+			opts.Metadata = metadata
+		}
+
+		taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Images.Upload, opts, clientV2, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("error uploading image: %s", err)
+		}
+		imageIDs = taskResult.Images
+	} else {
+		opts := &edgecloudV2.ImageCreateRequest{
+			Name:           d.Get("name").(string),
+			Source:         edgecloudV2.ImageSourceVolume,
+			VolumeID:       d.Get("volume_id").(string),
+			OSType:         edgecloudV2.OSType(d.Get("os_type").(string)),
+			SSHKey:         edgecloudV2.SSHKeyType(d.Get("ssh_key").(string)),
+			HwMachineType:  edgecloudV2.HwMachineType(d.Get("hw_machine_type").(string)),
+			HwFirmwareType: edgecloudV2.HwFirmwareType(d.Get("hw_firmware_type").(string)),
+			IsBaremetal:    &isBaremetal,
+		}
+		if len(metadata) > 0 {
+			opts.Metadata = metadata
+		}
+
+		taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Images.Create, opts, clientV2, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("error creating image: %s", err)
+		}
+		imageIDs = taskResult.Images
+	}
+
+	imageID := imageIDs[0]
+	d.SetId(imageID)
+	log.Printf("[DEBUG] Finish Image creating (%s)", imageID)
+
+	return resourceImageRead(ctx, d, m)
+}
+
+func resourceImageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Image reading")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image, resp, err := clientV2.Images.Get(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing image %s because resource doesn't exist anymore", d.Id())
+			d.SetId("")
+
+			return diags
+		}
+
+		return diag.Errorf("cannot get image with ID: %s. Error: %s", d.Id(), err)
+	}
+
+	d.Set("name", image.Name)
+	d.Set("os_distro", image.OSDistro)
+	d.Set("os_version", image.OSVersion)
+	d.Set("os_type", image.OSType)
+	d.Set("ssh_key", image.SSHKey)
+	d.Set("hw_machine_type", image.HwMachineType)
+	d.Set("hw_firmware_type", image.HwFirmwareType)
+	d.Set("is_baremetal", image.IsBaremetal)
+	d.Set("min_disk", image.MinDisk)
+	d.Set("min_ram", image.MinRAM)
+	d.Set("disk_format", image.DiskFormat)
+	d.Set("size", image.Size)
+	d.Set("status", image.Status)
+
+	metadataMap, metadataReadOnly := PrepareMetadata(image.MetadataDetailed)
+	if err = cloudMetadata.setFromAPI(d, m, metadataMap); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("metadata_read_only", metadataReadOnly); err != nil {
+		return diag.FromErr(err)
+	}
+
+	fields := []string{"project_id", "region_id"}
+	revertState(d, &fields)
+
+	log.Println("[DEBUG] Finish Image reading")
+
+	return diags
+}
+
+func resourceImageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Image updating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "os_type", "ssh_key", "hw_machine_type", "hw_firmware_type", "is_baremetal") {
+		isBaremetal := d.Get("is_baremetal").(bool)
+		opts := &edgecloudV2.ImageUpdateRequest{
+			Name:           d.Get("name").(string),
+			OSType:         edgecloudV2.OSType(d.Get("os_type").(string)),
+			SSHKey:         edgecloudV2.SSHKeyType(d.Get("ssh_key").(string)),
+			HwMachineType:  edgecloudV2.HwMachineType(d.Get("hw_machine_type").(string)),
+			HwFirmwareType: edgecloudV2.HwFirmwareType(d.Get("hw_firmware_type").(string)),
+			IsBaremetal:    &isBaremetal,
+		}
+		if _, _, err := clientV2.Images.Update(ctx, d.Id(), opts); err != nil {
+			return diag.Errorf("cannot update image with ID: %s. Error: %s", d.Id(), err)
+		}
+	}
+
+	log.Println("[DEBUG] Finish Image updating")
+
+	return resourceImageRead(ctx, d, m)
+}
+
+func resourceImageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Image deleting")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imageID := d.Id()
+	results, resp, err := clientV2.Images.Delete(ctx, imageID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if err := utilV2.WaitForTaskComplete(ctx, clientV2, results.Tasks[0], d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	log.Printf("[DEBUG] Finish of Image deleting")
+
+	return diags
+}
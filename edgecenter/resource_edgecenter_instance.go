@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"reflect"
 	"sort"
 	"strconv"
 	"time"
@@ -38,6 +37,7 @@ func resourceInstance() *schema.Resource {
 		ReadContext:        resourceInstanceRead,
 		UpdateContext:      resourceInstanceUpdate,
 		DeleteContext:      resourceInstanceDelete,
+		CustomizeDiff:      instanceMetadata.customizeDiff,
 		Description:        "**WARNING:** Resource \"instance\" is deprecated and unavailable.\n Use edgecenter_instanceV2 resource instead.\n The v2migrate tool converts the project to V2 without recreating resources, see the [v1 to v2 migration guide](https://registry.terraform.io/providers/Edge-Center/edgecenter/latest/docs/guides/v1-to-v2-migration).\n\n A cloud instance is a virtual machine in a cloud environment.",
 		DeprecationMessage: "!> **WARNING:** This resource is deprecated and will be removed in the next major version. Use edgecenter_instanceV2 resource instead. The v2migrate tool converts the project without recreating resources, see the guide: https://registry.terraform.io/providers/Edge-Center/edgecenter/latest/docs/guides/v1-to-v2-migration",
 
@@ -57,32 +57,32 @@ func resourceInstance() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Computed:      true,
+				Optional:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -304,6 +304,7 @@ func resourceInstance() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			MetadataAllField: metadataAllSchema(),
 			"configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -461,22 +462,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 		createOpts.Interfaces = ifaceCreateOptsList
 	}
 
-	if v, ok := d.GetOk("metadata"); ok {
-		metadataKV, err := extractKeyValueV2(v.([]interface{}))
-		if err != nil {
-			diag.FromErr(err)
-		}
-		metadata, err := MapInterfaceToMapString(metadataKV)
-		if err != nil {
-			diag.FromErr(err)
-		}
-		createOpts.Metadata = *metadata
-	} else if metadataRaw, ok := d.GetOk("metadata_map"); ok {
-		metadata, err := MapInterfaceToMapString(metadataRaw)
-		if err != nil {
-			diag.FromErr(err)
-		}
-		createOpts.Metadata = *metadata
+	if metadata := instanceMetadata.merged(d, m); len(metadata) > 0 {
+		createOpts.Metadata = metadata
 	}
 
 	configuration := d.Get("configuration")
@@ -684,6 +671,14 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 	}
 
+	apiMetadata, err := prepareMetadataFromAPI(ctx, clientV2, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := instanceMetadata.setManagedFromAPI(d, m, apiMetadata); err != nil {
+		return diag.FromErr(err)
+	}
+
 	addresses := []map[string][]map[string]string{}
 	for _, data := range instance.Addresses {
 		d := map[string][]map[string]string{}
@@ -751,41 +746,9 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 
-	if d.HasChange("metadata") {
-		omd, nmd := d.GetChange("metadata")
-		if len(omd.([]interface{})) > 0 {
-			for _, data := range omd.([]interface{}) {
-				d := data.(map[string]interface{})
-				k := d["key"].(string)
-				_, err = clientV2.Instances.MetadataDeleteItem(ctx, instanceID, &edgecloudV2.MetadataItemOptions{Key: k})
-				if err != nil {
-					return diag.Errorf("cannot delete metadata key: %s. Error: %s", k, err)
-				}
-			}
-		}
-		MetaData := make(edgecloudV2.Metadata)
-		if len(nmd.([]interface{})) > 0 {
-			for _, data := range nmd.([]interface{}) {
-				d := data.(map[string]interface{})
-				MetaData[d["key"].(string)] = d["value"].(string)
-			}
-
-			_, err = clientV2.Instances.MetadataCreate(ctx, instanceID, &MetaData)
-			if err != nil {
-				return diag.Errorf("cannot create metadata. Error: %s", err)
-			}
-		}
-	} else if d.HasChange("metadata_map") {
-		omd, nmd := d.GetChange("metadata_map")
-		if !reflect.DeepEqual(omd, nmd) {
-			MetaData := make(edgecloudV2.Metadata)
-			for k, v := range nmd.(map[string]interface{}) {
-				MetaData[k] = v.(string)
-			}
-			_, err = clientV2.Instances.MetadataCreate(ctx, instanceID, &MetaData)
-			if err != nil {
-				return diag.Errorf("cannot create metadata. Error: %s", err)
-			}
+	if instanceMetadata.hasChange(d) {
+		if err := updateInstanceMetadata(ctx, clientV2, instanceID, d, m, instanceMetadata); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
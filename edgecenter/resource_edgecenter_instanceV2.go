@@ -3,10 +3,10 @@ package edgecenter
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
-	"reflect"
 	"slices"
 	"sort"
 	"strconv"
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,6 +28,7 @@ const (
 	InstanceVolumeIDField              = "volume_id"
 	InstanceBootVolumesField           = "boot_volumes"
 	InstanceDataVolumesField           = "data_volumes"
+	InstanceNonExclusiveDataVolumes    = "non_exclusive_data_volumes"
 	InstanceInterfacesField            = "interfaces"
 	InstanceVMStateField               = "vm_state"
 	InstanceAddressesField             = "addresses"
@@ -51,6 +53,7 @@ func resourceInstanceV2() *schema.Resource {
 		ReadContext:   resourceInstanceReadV2,
 		UpdateContext: resourceInstanceUpdateV2,
 		DeleteContext: resourceInstanceDeleteV2,
+		CustomizeDiff: customdiff.All(instanceV2Metadata.customizeDiff, instanceV2QuotaDiff),
 		Description:   "A cloud instance is a virtual machine in a cloud environment.",
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -68,32 +71,32 @@ func resourceInstanceV2() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			ProjectIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectNameField},
 			},
 			ProjectNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectIDField},
 			},
 			RegionIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionNameField},
 			},
 			RegionNameField: {
-				Type:         schema.TypeString,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:          schema.TypeString,
+				Computed:      true,
+				Optional:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionIDField},
 			},
 			NameField: {
 				Type:        schema.TypeString,
@@ -158,6 +161,11 @@ func resourceInstanceV2() *schema.Resource {
 					},
 				},
 			},
+			InstanceNonExclusiveDataVolumes: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to true to manage only the volumes listed in 'data_volumes' and ignore the other volumes attached to the instance, e.g. by 'edgecenter_volume_attachment'.",
+			},
 			InstanceDataVolumesField: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -237,7 +245,7 @@ interfaces. You must always have exactly one interface with set attribute 'is_de
 						InstanceReservedFixedIPPortIDField: {
 							Default:      "",
 							Type:         schema.TypeString,
-							Description:  "required if type is  'reserved_fixed_ip'",
+							Description:  "The ID (uuid) of the 'edgecenter_port' or the 'edgecenter_reservedfixedip' port. Required if type is 'reserved_fixed_ip'.",
 							Optional:     true,
 							ValidateFunc: validation.IsUUID,
 						},
@@ -285,6 +293,7 @@ interfaces. You must always have exactly one interface with set attribute 'is_de
 					Type: schema.TypeString,
 				},
 			},
+			MetadataAllField: metadataAllSchema(),
 			InstanceConfigurationField: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -336,6 +345,104 @@ allowing you to start or stop the VM. Possible values are %s and %s.`, InstanceV
 	}
 }
 
+// moveInstanceStateToV2 rewrites the state of an edgecenter_instance for edgecenter_instanceV2.
+// It maps the attributes the same way v2migrate rewrites the configuration, so a converted
+// configuration plans no changes after the move.
+func moveInstanceStateToV2(source map[string]interface{}) (map[string]interface{}, error) {
+	target := make(map[string]interface{})
+	CopyStateAttrs(target, source,
+		IDField, ProjectIDField, ProjectNameField, RegionIDField, RegionNameField, NameField,
+		FlavorIDField, FlavorField, InstanceNameTemplateField, InstanceKeypairNameField, InstanceServerGroupField,
+		PasswordField, UsernameField, InstanceConfigurationField, InstanceAllowAppPortsField,
+		StatusField, InstanceVMStateField, MetadataAllField,
+	)
+	if id, _ := target[IDField].(string); id == "" {
+		return nil, errors.New("the edgecenter_instance state has no id")
+	}
+
+	if templates, _ := source["name_templates"].([]interface{}); target[InstanceNameTemplateField] == nil && len(templates) == 1 {
+		target[InstanceNameTemplateField] = templates[0]
+	}
+	for _, key := range []string{InstanceUserDataField, "userdata"} {
+		if v, _ := source[key].(string); v != "" {
+			target[InstanceUserDataField] = v
+			break
+		}
+	}
+
+	if md, _ := source[MetadataMapField].(map[string]interface{}); len(md) > 0 {
+		target[MetadataField] = md
+	} else if items := StateBlockItems(source, MetadataField); len(items) > 0 {
+		md := make(map[string]interface{}, len(items))
+		for _, item := range items {
+			if key, ok := item[KeyField].(string); ok {
+				md[key] = item[ValueField]
+			}
+		}
+		target[MetadataField] = md
+	}
+
+	target[InstanceInterfacesField] = moveInstanceInterfacesToV2(StateBlockItems(source, "interface"))
+
+	var bootVolumes, dataVolumes []interface{}
+	for _, volume := range StateBlockItems(source, "volume") {
+		v2 := map[string]interface{}{InstanceVolumesAttachmentTagField: ""}
+		CopyStateAttrs(v2, volume, NameField, TypeNameField, InstanceVolumeSizeField, InstanceVolumesAttachmentTagField)
+		v2[InstanceVolumeIDField] = volume[InstanceVolumeIDField]
+		if id, _ := v2[InstanceVolumeIDField].(string); id == "" {
+			v2[InstanceVolumeIDField] = volume[IDField]
+		}
+		// An unset boot_index is stored as 0, so such volumes are boot volumes, as in v2migrate.
+		if bootIndex, _ := volume[InstanceBootVolumesBootIndexField].(float64); bootIndex == 0 {
+			v2[InstanceBootVolumesBootIndexField] = 0
+			bootVolumes = append(bootVolumes, v2)
+		} else {
+			dataVolumes = append(dataVolumes, v2)
+		}
+	}
+	target[InstanceBootVolumesField] = bootVolumes
+	target[InstanceDataVolumesField] = dataVolumes
+
+	return target, nil
+}
+
+// moveInstanceInterfacesToV2 maps the v1 interfaces to the V2 ones. The interface with the lowest
+// order becomes the default one. Networks and subnets are only kept for subnet interfaces, for the
+// other types they are not part of the configuration.
+func moveInstanceInterfacesToV2(ifaces []map[string]interface{}) []interface{} {
+	sort.SliceStable(ifaces, func(i, j int) bool {
+		left, _ := ifaces[i]["order"].(float64)
+		right, _ := ifaces[j]["order"].(float64)
+
+		return left < right
+	})
+
+	interfaces := make([]interface{}, 0, len(ifaces))
+	for i, iface := range ifaces {
+		ifaceType, _ := iface[TypeField].(string)
+		v2 := map[string]interface{}{
+			TypeField:                          ifaceType,
+			IsDefaultField:                     i == 0,
+			NetworkIDField:                     "",
+			SubnetIDField:                      "",
+			InstanceReservedFixedIPPortIDField: "",
+		}
+		switch edgecloudV2.InterfaceType(ifaceType) {
+		case edgecloudV2.InterfaceTypeSubnet, edgecloudV2.InterfaceTypeAnySubnet:
+			CopyStateAttrs(v2, iface, NetworkIDField, SubnetIDField)
+		case edgecloudV2.InterfaceTypeReservedFixedIP:
+			if portID, ok := iface[PortIDField].(string); ok {
+				v2[InstanceReservedFixedIPPortIDField] = portID
+			}
+		case edgecloudV2.InterfaceTypeExternal:
+		}
+		CopyStateAttrs(v2, iface, PortIDField, IPAddressField)
+		interfaces = append(interfaces, v2)
+	}
+
+	return interfaces
+}
+
 func resourceInstanceCreateV2(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("[DEBUG] Start Instance creating")
 	var diags diag.Diagnostics
@@ -405,12 +512,8 @@ func resourceInstanceCreateV2(ctx context.Context, d *schema.ResourceData, m int
 		createOpts.Interfaces = ifaceCreateOptsList
 	}
 
-	if metadataRaw, ok := d.GetOk(MetadataField); ok {
-		metadata, err := MapInterfaceToMapString(metadataRaw)
-		if err != nil {
-			diag.FromErr(err)
-		}
-		createOpts.Metadata = *metadata
+	if metadata := instanceV2Metadata.merged(d, m); len(metadata) > 0 {
+		createOpts.Metadata = metadata
 	}
 
 	configuration := d.Get(InstanceConfigurationField)
@@ -506,7 +609,7 @@ func resourceInstanceReadV2(ctx context.Context, d *schema.ResourceData, m inter
 
 	var enrichedDataVolumesData []interface{}
 
-	if len(dataVolumesList) == 0 {
+	if len(dataVolumesList) == 0 && !d.Get(InstanceNonExclusiveDataVolumes).(bool) {
 		enrichedDataVolumesData = prepareDataVolumesDataFromAPI(instanceVolumes)
 	} else {
 		dataVolumesState := extractVolumesIntoMap(dataVolumesList)
@@ -573,12 +676,16 @@ func resourceInstanceReadV2(ctx context.Context, d *schema.ResourceData, m inter
 
 	// We can't use a MetadataField to check if a state file is availabl,e because the MetadataField is optional.
 	// So we will use the required InstanceInterfacesField.
+	apiMetadata, err := prepareMetadataFromAPI(ctx, clientV2, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if len(ifs) == 0 {
-		newMetadata, err := prepareMetadataFromAPI(ctx, clientV2, instanceID)
+		apiMetadataMap, err := MapInterfaceToMapString(apiMetadata)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-
+		newMetadata := WithoutDefaultMetadata(*apiMetadataMap, m)
 		if len(newMetadata) != 0 {
 			if err = d.Set(MetadataField, newMetadata); err != nil {
 				return diag.FromErr(err)
@@ -600,6 +707,9 @@ func resourceInstanceReadV2(ctx context.Context, d *schema.ResourceData, m inter
 			}
 		}
 	}
+	if err = instanceV2Metadata.setManagedFromAPI(d, m, apiMetadata); err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Println("[DEBUG] Finish Instance reading")
 
@@ -680,17 +790,9 @@ func resourceInstanceUpdateV2(ctx context.Context, d *schema.ResourceData, m int
 		}
 	}
 
-	if d.HasChange(MetadataField) {
-		omd, nmd := d.GetChange(MetadataField)
-		if !reflect.DeepEqual(omd, nmd) {
-			MetaData := make(edgecloudV2.Metadata)
-			for k, v := range nmd.(map[string]interface{}) {
-				MetaData[k] = v.(string)
-			}
-			_, err = clientV2.Instances.MetadataCreate(ctx, instanceID, &MetaData)
-			if err != nil {
-				return diag.Errorf("cannot create metadata. Error: %s", err)
-			}
+	if instanceV2Metadata.hasChange(d) {
+		if err := updateInstanceMetadata(ctx, clientV2, instanceID, d, m, instanceV2Metadata); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -912,3 +1014,25 @@ func resourceInstanceDeleteV2(ctx context.Context, d *schema.ResourceData, m int
 
 	return diags
 }
+
+// instanceV2QuotaDiff checks the quota demand of a new instance or of the new flavor of an instance.
+func instanceV2QuotaDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if (d.Id() != "" && !d.HasChange(FlavorIDField)) || !d.NewValueKnown(FlavorIDField) {
+		return nil
+	}
+
+	return CheckQuotaDemand(ctx, d, m, func(ctx context.Context, client *edgecloudV2.Client) (QuotaDemand, error) {
+		oldFlavorID, newFlavorID := d.GetChange(FlavorIDField)
+		demand, err := FlavorQuotaDemand(ctx, client, newFlavorID.(string), 1)
+		if err != nil || d.Id() == "" {
+			return demand, err
+		}
+		old, err := FlavorQuotaDemand(ctx, client, oldFlavorID.(string), 1)
+		if err != nil {
+			return nil, err
+		}
+
+		// The number of instances does not change on resize.
+		return demand.Sub(old), nil
+	})
+}
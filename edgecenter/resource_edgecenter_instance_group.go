@@ -0,0 +1,505 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	InstanceGroupInstanceCountField = "instance_count"
+	InstanceGroupBootVolumeField    = "boot_volume"
+	InstanceGroupImageIDField       = "image_id"
+	InstanceGroupLBPoolField        = "lb_pool"
+	InstanceGroupPoolIDField        = "pool_id"
+	InstanceGroupProtocolPortField  = "protocol_port"
+	InstanceGroupWeightField        = "weight"
+	InstanceGroupMembersField       = "members"
+	InstanceGroupIPAddressesField   = "ip_addresses"
+	InstanceGroupPoolMemberIDsField = "pool_member_ids"
+)
+
+func resourceInstanceGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInstanceGroupCreate,
+		ReadContext:   resourceInstanceGroupRead,
+		UpdateContext: resourceInstanceGroupUpdate,
+		DeleteContext: resourceInstanceGroupDelete,
+		CustomizeDiff: customdiff.All(instanceGroupMetadata.customizeDiff, instanceGroupMembersDiff, instanceGroupQuotaDiff),
+		Description: `A group of identical instances created from a name template in a single request.
+Scaling up creates all new members in one task, scaling down deletes the newest members.
+The members can be registered in load balancer pools.`,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(InstanceCreateTimeout),
+			Update: schema.DefaultTimeout(InstanceCreateTimeout),
+			Delete: schema.DefaultTimeout(InstanceDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			ProjectIDField: {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectNameField},
+			},
+			ProjectNameField: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectIDField},
+			},
+			RegionIDField: {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionNameField},
+			},
+			RegionNameField: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionIDField},
+			},
+			InstanceNameTemplateField: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `A template used to generate the names of the members, e.g. 'worker-{ip_octets}'.
+The placeholders '{ip_octets}', '{two_ip_octets}' and '{one_ip_octet}' are replaced with the octets of the member IP address.`,
+			},
+			InstanceGroupInstanceCountField: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The number of the members.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			FlavorIDField: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the flavor of the members, for example 'g1-standard-2-4'. Changing it resizes every member.",
+			},
+			InstanceGroupBootVolumeField: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The boot volume created for every member from an image. It is deleted with the member.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						InstanceGroupImageIDField: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Description:  "The ID of the image the boot volumes are created from.",
+							ValidateFunc: validation.IsUUID,
+						},
+						InstanceVolumeSizeField: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							Description:  "The size of the boot volume, specified in gigabytes (GB).",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						TypeNameField: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     string(edgecloudV2.VolumeTypeStandard),
+							Description: "The type of the boot volume. Valid values are 'ssd_hiiops', 'standard', 'cold', and 'ultra'. Defaults to 'standard'.",
+						},
+					},
+				},
+			},
+			InstanceInterfacesField: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "The network interfaces of every member. The first one defines the default routing.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						TypeField: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							Description: fmt.Sprintf("Available values are '%s', '%s' and '%s'.",
+								edgecloudV2.InterfaceTypeSubnet, edgecloudV2.InterfaceTypeAnySubnet, edgecloudV2.InterfaceTypeExternal),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(edgecloudV2.InterfaceTypeSubnet), string(edgecloudV2.InterfaceTypeAnySubnet), string(edgecloudV2.InterfaceTypeExternal),
+							}, false),
+						},
+						NetworkIDField: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Description:  "Required if type is 'subnet' or 'any_subnet'.",
+							ValidateFunc: validation.IsUUID,
+						},
+						SubnetIDField: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Description:  "Required if type is 'subnet'.",
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+			InstanceKeypairNameField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the key pair to be associated with the members for SSH access.",
+			},
+			InstanceServerGroupField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID (uuid) of the server group the members belong to, e.g. an anti-affinity group.",
+			},
+			InstanceUserDataField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A field for specifying user data to be used for configuring the members at launch time.",
+			},
+			AvailabilityZoneField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The availability zone in which to create the members.",
+			},
+			MetadataField: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A map containing metadata of every member, for example tags.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			MetadataAllField: metadataAllSchema(),
+			InstanceGroupLBPoolField: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The load balancer pools every member is registered in.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						InstanceGroupPoolIDField: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The ID (uuid) of the load balancer pool.",
+							ValidateFunc: validation.IsUUID,
+						},
+						InstanceGroupProtocolPortField: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "The port on which the members listen.",
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						InstanceGroupWeightField: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "The weight of the members in the pool, from 0 to 256.",
+							ValidateFunc: validation.IntBetween(minWeight, maxWeight),
+						},
+						SubnetIDField: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The ID of the subnet whose member address is registered. Defaults to the address of the first interface.",
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+			InstanceGroupMembersField: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The members of the group, the oldest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance.",
+						},
+						NameField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the instance.",
+						},
+						StatusField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the instance.",
+						},
+						IPAddressField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the first interface of the instance.",
+						},
+						InstanceGroupIPAddressesField: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "All IP addresses of the instance.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						InstanceGroupPoolMemberIDsField: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The IDs of the load balancer pool members of the instance, keyed by the pool ID.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceInstanceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start InstanceGroup creating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	var members []*instanceGroupMember
+	if count := d.Get(InstanceGroupInstanceCountField).(int); count > 0 {
+		members, err = createInstanceGroupMembers(ctx, clientV2, d, m, count, d.Timeout(schema.TimeoutCreate))
+		// The members created before a failure are kept in the state, so they are not leaked.
+		setInstanceGroupMembers(d, members)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = registerInstanceGroupMembers(ctx, clientV2, expandInstanceGroupPools(d.Get(InstanceGroupLBPoolField)), members)
+	setInstanceGroupMembers(d, members)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finish InstanceGroup creating (%s)", d.Id())
+
+	return resourceInstanceGroupRead(ctx, d, m)
+}
+
+func resourceInstanceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start InstanceGroup reading")
+	log.Printf("[DEBUG] InstanceGroup id = %s", d.Id())
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set(ProjectIDField, clientV2.Project)
+	d.Set(RegionIDField, clientV2.Region)
+
+	stateMembers := expandInstanceGroupMembers(d.Get(InstanceGroupMembersField))
+	members := make([]*instanceGroupMember, 0, len(stateMembers))
+	var flavorID string
+	for _, stateMember := range stateMembers {
+		member, instance, err := readInstanceGroupMember(ctx, clientV2, stateMember.id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if member == nil {
+			log.Printf("[WARN] Removing instance %s from the instance group %s because it doesn't exist anymore", stateMember.id, d.Id())
+			continue
+		}
+		member.poolMemberIDs = stateMember.poolMemberIDs
+		members = append(members, member)
+		if flavorID == "" {
+			flavorID = instance.Flavor.FlavorID
+		}
+	}
+	setInstanceGroupMembers(d, members)
+
+	if len(members) > 0 {
+		d.Set(FlavorIDField, flavorID)
+
+		apiMetadata, err := prepareMetadataFromAPI(ctx, clientV2, members[0].id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := instanceGroupMetadata.setManagedFromAPI(d, m, apiMetadata); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Println("[DEBUG] Finish InstanceGroup reading")
+
+	return nil
+}
+
+func resourceInstanceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start InstanceGroup updating")
+	log.Printf("[DEBUG] InstanceGroup id = %s", d.Id())
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldPoolsRaw, newPoolsRaw := d.GetChange(InstanceGroupLBPoolField)
+	oldPools, newPools := expandInstanceGroupPools(oldPoolsRaw), expandInstanceGroupPools(newPoolsRaw)
+
+	members := expandInstanceGroupMembers(d.Get(InstanceGroupMembersField))
+	count := d.Get(InstanceGroupInstanceCountField).(int)
+
+	// Scale down first, so the deleted members are neither resized nor registered in new pools.
+	if len(members) > count {
+		removed := members[count:]
+		if err := deregisterInstanceGroupMembers(ctx, clientV2, oldPools, removed); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := deleteInstanceGroupMembers(ctx, clientV2, removed, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+		members = members[:count]
+		setInstanceGroupMembers(d, members)
+	}
+
+	if d.HasChange(InstanceGroupLBPoolField) {
+		if err := deregisterInstanceGroupMembers(ctx, clientV2, oldPools, members); err != nil {
+			return diag.FromErr(err)
+		}
+		err := registerInstanceGroupMembers(ctx, clientV2, newPools, members)
+		setInstanceGroupMembers(d, members)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(FlavorIDField) {
+		flavorID := d.Get(FlavorIDField).(string)
+		for _, member := range members {
+			if err := resizeInstanceGroupMember(ctx, clientV2, member.id, flavorID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if instanceGroupMetadata.hasChange(d) {
+		for _, member := range members {
+			if err := updateInstanceMetadata(ctx, clientV2, member.id, d, m, instanceGroupMetadata); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if len(members) < count {
+		created, err := createInstanceGroupMembers(ctx, clientV2, d, m, count-len(members), d.Timeout(schema.TimeoutUpdate))
+		members = append(members, created...)
+		setInstanceGroupMembers(d, members)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = registerInstanceGroupMembers(ctx, clientV2, newPools, created)
+		setInstanceGroupMembers(d, members)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Println("[DEBUG] Finish InstanceGroup updating")
+
+	return resourceInstanceGroupRead(ctx, d, m)
+}
+
+func resourceInstanceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start InstanceGroup deleting")
+	log.Printf("[DEBUG] InstanceGroup id = %s", d.Id())
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members := expandInstanceGroupMembers(d.Get(InstanceGroupMembersField))
+	if err := deregisterInstanceGroupMembers(ctx, clientV2, expandInstanceGroupPools(d.Get(InstanceGroupLBPoolField)), members); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := deleteInstanceGroupMembers(ctx, clientV2, members, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	log.Println("[DEBUG] Finish of InstanceGroup deleting")
+
+	return nil
+}
+
+// instanceGroupMembersDiff plans new members when the number of members or the pools change,
+// or when members were deleted outside of Terraform.
+func instanceGroupMembersDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	count := d.Get(InstanceGroupInstanceCountField).(int)
+	members := d.Get(InstanceGroupMembersField).([]interface{})
+	if d.HasChange(InstanceGroupInstanceCountField) || d.HasChange(InstanceGroupLBPoolField) || len(members) != count {
+		if err := d.SetNewComputed(InstanceGroupMembersField); err != nil {
+			return fmt.Errorf("mark %s as computed: %w", InstanceGroupMembersField, err)
+		}
+	}
+
+	return nil
+}
+
+// instanceGroupQuotaDiff checks the quota demand of the new members and of the new flavor of the existing ones.
+func instanceGroupQuotaDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChanges(InstanceGroupInstanceCountField, FlavorIDField) || !d.NewValueKnown(FlavorIDField) ||
+		!d.NewValueKnown(InstanceGroupInstanceCountField) || !d.NewValueKnown(InstanceGroupBootVolumeField) {
+		return nil
+	}
+
+	return CheckQuotaDemand(ctx, d, m, func(ctx context.Context, client *edgecloudV2.Client) (QuotaDemand, error) {
+		oldFlavorID, newFlavorID := d.GetChange(FlavorIDField)
+		oldCountRaw, newCountRaw := d.GetChange(InstanceGroupInstanceCountField)
+		oldCount, newCount := oldCountRaw.(int), newCountRaw.(int)
+		if d.Id() == "" {
+			oldCount = 0
+		}
+
+		demand, err := FlavorQuotaDemand(ctx, client, newFlavorID.(string), newCount)
+		if err != nil {
+			return nil, err
+		}
+		if oldCount > 0 {
+			old, err := FlavorQuotaDemand(ctx, client, oldFlavorID.(string), oldCount)
+			if err != nil {
+				return nil, err
+			}
+			demand = demand.Sub(old)
+		}
+
+		bootVolume := expandInstanceGroupBootVolume(d.Get(InstanceGroupBootVolumeField))
+		demand[QuotaVolumeCount] = newCount - oldCount
+		demand[QuotaVolumeSize] = (newCount - oldCount) * bootVolume.size
+
+		return demand, nil
+	})
+}
@@ -20,26 +20,33 @@ func resourceKeypair() *schema.Resource {
 		Description:   "Represent a ssh key, do not depends on region",
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"public_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
-				Description: "The public portion of the SSH key pair.",
+				Description: "The public portion of the SSH key pair. When it is not set, the key pair is generated and its private key is returned in 'private_key'.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private portion of the generated SSH key pair. It is only set when the key pair is generated, since the API returns it only once. Use the 'edgecenter_keypair' ephemeral resource to keep the private key out of the state.",
 			},
 			"sshkey_name": {
 				Type:        schema.TypeString,
@@ -78,6 +85,7 @@ func resourceKeypairCreate(ctx context.Context, d *schema.ResourceData, m interf
 	// Therefore, a stub with a value of 1 is applied for the region.
 	clientV2.Region = 1
 
+	// Without a public key, the API generates the key pair and returns its private key.
 	opts := &edgecloudV2.KeyPairCreateRequestV2{
 		SSHKeyName: d.Get("sshkey_name").(string),
 		PublicKey:  d.Get("public_key").(string),
@@ -91,6 +99,7 @@ func resourceKeypairCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	log.Printf("[DEBUG] KeyPair id (%s)", kp.SSHKeyID)
 	d.SetId(kp.SSHKeyID)
+	d.Set("private_key", kp.PrivateKey)
 
 	resourceKeypairRead(ctx, d, m)
 
@@ -0,0 +1,300 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+const (
+	LBHealthMonitorCreateTimeout = 2400 * time.Second
+	LBHealthMonitorUpdateTimeout = 2400 * time.Second
+)
+
+func resourceLBHealthMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLBHealthMonitorCreate,
+		ReadContext:   resourceLBHealthMonitorRead,
+		UpdateContext: resourceLBHealthMonitorUpdate,
+		DeleteContext: resourceLBHealthMonitorDelete,
+		Description: `Represent a health monitor of a load balancer pool, managed apart from the pool.
+Set 'ignore_health_monitor' on the 'edgecenter_lbpool' so the pool leaves the monitor alone.`,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, healthMonitorID, lbPoolID, err := ImportStringParserExtended(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectID)
+				d.Set("region_id", regionID)
+				d.Set("pool_id", lbPoolID)
+				d.SetId(healthMonitorID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
+			},
+			"project_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
+			},
+			"region_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
+			},
+			"pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The uuid of the load balancer pool. A pool has at most one health monitor.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: fmt.Sprintf("The type of the health monitor. Available values are `%s`, `%s`, `%s`, `%s`, `%s`, `%s`.",
+					edgecloudV2.HealthMonitorTypeHTTP, edgecloudV2.HealthMonitorTypeHTTPS, edgecloudV2.HealthMonitorTypePING,
+					edgecloudV2.HealthMonitorTypeTCP, edgecloudV2.HealthMonitorTypeTLSHello, edgecloudV2.HealthMonitorTypeUDPConnect),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(edgecloudV2.HealthMonitorTypeHTTP), string(edgecloudV2.HealthMonitorTypeHTTPS), string(edgecloudV2.HealthMonitorTypePING),
+					string(edgecloudV2.HealthMonitorTypeTCP), string(edgecloudV2.HealthMonitorTypeTLSHello), string(edgecloudV2.HealthMonitorTypeUDPConnect),
+				}, false),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The time between sending probes to members (in seconds).",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The maximum time to connect. Must be less than the delay value.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The number of successes before the member is switched to the ONLINE state.",
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"max_retries_down": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The number of failures before the member is switched to the ERROR state.",
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"http_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: fmt.Sprintf("The HTTP method of HTTP and HTTPS monitors. Available values are `%s`, `%s`, `%s`, `%s`, `%s`, `%s`, `%s`, `%s`, `%s`.",
+					edgecloudV2.HTTPMethodCONNECT, edgecloudV2.HTTPMethodDELETE, edgecloudV2.HTTPMethodGET, edgecloudV2.HTTPMethodHEAD, edgecloudV2.HTTPMethodOPTIONS,
+					edgecloudV2.HTTPMethodPATCH, edgecloudV2.HTTPMethodPOST, edgecloudV2.HTTPMethodPUT, edgecloudV2.HTTPMethodTRACE),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(edgecloudV2.HTTPMethodCONNECT), string(edgecloudV2.HTTPMethodDELETE), string(edgecloudV2.HTTPMethodGET),
+					string(edgecloudV2.HTTPMethodHEAD), string(edgecloudV2.HTTPMethodOPTIONS), string(edgecloudV2.HTTPMethodPATCH),
+					string(edgecloudV2.HTTPMethodPOST), string(edgecloudV2.HTTPMethodPUT), string(edgecloudV2.HTTPMethodTRACE),
+				}, false),
+			},
+			"url_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The URL path of HTTP and HTTPS monitors. Defaults to `/`.",
+			},
+			"expected_codes": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The expected HTTP status codes of HTTP and HTTPS monitors. Multiple codes can be specified as a comma-separated string or a range, e.g. `200,202` or `200-204`.",
+			},
+		},
+	}
+}
+
+// healthMonitorFields are the fields of a health monitor shared with the health_monitor block of edgecenter_lbpool.
+var healthMonitorFields = []string{"type", "delay", "timeout", "max_retries", "max_retries_down", "http_method", "url_path", "expected_codes"}
+
+func expandLBHealthMonitor(d *schema.ResourceData) *edgecloudV2.HealthMonitorCreateRequest {
+	hm := make(map[string]interface{}, len(healthMonitorFields))
+	for _, field := range healthMonitorFields {
+		hm[field] = d.Get(field)
+	}
+
+	return expandHealthMonitorV2(hm)
+}
+
+func resourceLBHealthMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LBHealthMonitor creating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	results, _, err := clientV2.Loadbalancers.HealthMonitorCreate(ctx, poolID, expandLBHealthMonitor(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	taskID := results.Tasks[0]
+	if err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBHealthMonitorCreateTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	pool, _, err := clientV2.Loadbalancers.PoolGet(ctx, poolID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if pool.HealthMonitor == nil {
+		return diag.Errorf("health monitor of pool %s not found after creation", poolID)
+	}
+
+	d.SetId(pool.HealthMonitor.ID)
+	log.Printf("[DEBUG] Finish LBHealthMonitor creating (%s)", d.Id())
+
+	return resourceLBHealthMonitorRead(ctx, d, m)
+}
+
+func resourceLBHealthMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LBHealthMonitor reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	pool, resp, err := clientV2.Loadbalancers.PoolGet(ctx, poolID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing health monitor %s from state because pool %s doesn't exist anymore", d.Id(), poolID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	healthMonitor := flattenHealthMonitorV2(pool)
+	if healthMonitor == nil || healthMonitor["id"] != d.Id() {
+		log.Printf("[WARN] Removing health monitor %s from state because pool %s doesn't have it anymore", d.Id(), poolID)
+		d.SetId("")
+		return nil
+	}
+
+	for _, field := range healthMonitorFields {
+		if v, ok := healthMonitor[field]; ok {
+			d.Set(field, v)
+		}
+	}
+
+	fields := []string{"project_id", "region_id"}
+	revertState(d, &fields)
+
+	log.Println("[DEBUG] Finish LBHealthMonitor reading")
+
+	return nil
+}
+
+func resourceLBHealthMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LBHealthMonitor updating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	pool, _, err := clientV2.Loadbalancers.PoolGet(ctx, poolID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The pool update only changes the monitor: the other pool fields are not sent.
+	healthOpts := expandLBHealthMonitor(d)
+	healthOpts.ID = d.Id()
+	opts := &edgecloudV2.PoolUpdateRequest{Name: pool.Name, HealthMonitor: healthOpts}
+
+	results, _, err := clientV2.Loadbalancers.PoolUpdate(ctx, poolID, opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	taskID := results.Tasks[0]
+	if err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBHealthMonitorUpdateTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Println("[DEBUG] Finish LBHealthMonitor updating")
+
+	return resourceLBHealthMonitorRead(ctx, d, m)
+}
+
+func resourceLBHealthMonitorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LBHealthMonitor deleting")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	resp, err := clientV2.Loadbalancers.HealthMonitorDelete(ctx, poolID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			log.Println("[DEBUG] Finish of LBHealthMonitor deleting")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	log.Println("[DEBUG] Finish of LBHealthMonitor deleting")
+
+	return nil
+}
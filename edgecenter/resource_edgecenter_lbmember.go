@@ -52,32 +52,36 @@ func resourceLBMember() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"pool_id": {
 				Type:        schema.TypeString,
@@ -86,18 +90,10 @@ func resourceLBMember() *schema.Resource {
 				Description: "The uuid for the load balancer pool.",
 			},
 			"address": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The IP address of the load balancer pool member.",
-				ValidateDiagFunc: func(val interface{}, key cty.Path) diag.Diagnostics {
-					v := val.(string)
-					ip := net.ParseIP(v)
-					if ip != nil {
-						return diag.Diagnostics{}
-					}
-
-					return diag.FromErr(fmt.Errorf("%q must be a valid ip, got: %s", key, v))
-				},
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The IP address of the load balancer pool member.",
+				ValidateDiagFunc: validatePoolMemberAddress,
 			},
 			"protocol_port": {
 				Type:        schema.TypeInt,
@@ -105,16 +101,10 @@ func resourceLBMember() *schema.Resource {
 				Description: "The port on which the member listens for requests.",
 			},
 			"weight": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "A weight value between 0 and 256, determining the distribution of requests among the members of the pool.",
-				ValidateDiagFunc: func(val interface{}, path cty.Path) diag.Diagnostics {
-					v := val.(int)
-					if v >= minWeight && v <= maxWeight {
-						return nil
-					}
-					return diag.Errorf("Valid values: %d to %d got: %d", minWeight, maxWeight, v)
-				},
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Description:      "A weight value between 0 and 256, determining the distribution of requests among the members of the pool.",
+				ValidateDiagFunc: validatePoolMemberWeight,
 			},
 			"subnet_id": {
 				Type:        schema.TypeString,
@@ -269,14 +259,7 @@ func resourceLBMemberUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	members := make([]edgecloudV2.PoolMemberCreateRequest, len(pool.Members))
 	for i, pm := range pool.Members {
 		if pm.ID != d.Id() {
-			members[i] = edgecloudV2.PoolMemberCreateRequest{
-				Address:      pm.Address,
-				ProtocolPort: pm.ProtocolPort,
-				Weight:       pm.Weight,
-				SubnetID:     pm.SubnetID,
-				InstanceID:   pm.InstanceID,
-				ID:           pm.ID,
-			}
+			members[i] = poolMemberRequest(pm)
 			continue
 		}
 
@@ -290,17 +273,7 @@ func resourceLBMemberUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 
-	opts := &edgecloudV2.PoolUpdateRequest{Name: pool.Name, Members: members}
-
-	results, _, err := clientV2.Loadbalancers.PoolUpdate(ctx, pool.ID, opts)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	taskID := results.Tasks[0]
-
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBMemberUpdateTimeout)
-	if err != nil {
+	if err := updatePoolMembers(ctx, clientV2, pool, members); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -322,25 +295,90 @@ func resourceLBMemberDelete(ctx context.Context, d *schema.ResourceData, m inter
 	mid := d.Id()
 	pid := d.Get("pool_id").(string)
 
-	results, resp, err := clientV2.Loadbalancers.PoolMemberDelete(ctx, pid, mid)
-	if err != nil {
-		if resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			log.Printf("[DEBUG] Finish of LBMember deleting")
-			return diags
-		}
+	if err := deletePoolMember(ctx, clientV2, pid, mid); err != nil {
 		return diag.FromErr(err)
 	}
 
+	d.SetId("")
+	log.Printf("[DEBUG] Finish of LBMember deleting")
+
+	return diags
+}
+
+func validatePoolMemberAddress(val interface{}, key cty.Path) diag.Diagnostics {
+	v := val.(string)
+	ip := net.ParseIP(v)
+	if ip != nil {
+		return diag.Diagnostics{}
+	}
+
+	return diag.FromErr(fmt.Errorf("%q must be a valid ip, got: %s", key, v))
+}
+
+func validatePoolMemberWeight(val interface{}, _ cty.Path) diag.Diagnostics {
+	v := val.(int)
+	if v >= minWeight && v <= maxWeight {
+		return nil
+	}
+	return diag.Errorf("Valid values: %d to %d got: %d", minWeight, maxWeight, v)
+}
+
+// poolMemberRequest returns the request that keeps an existing member unchanged in a pool update.
+func poolMemberRequest(pm edgecloudV2.PoolMember) edgecloudV2.PoolMemberCreateRequest {
+	return edgecloudV2.PoolMemberCreateRequest{
+		Address:      pm.Address,
+		ProtocolPort: pm.ProtocolPort,
+		Weight:       pm.Weight,
+		SubnetID:     pm.SubnetID,
+		InstanceID:   pm.InstanceID,
+		ID:           pm.ID,
+	}
+}
+
+// updatePoolMembers replaces the members of the pool with members in a single pool update. Members with
+// an ID are kept or updated, the ones without an ID are added and the current members missing from members
+// are removed. An empty members list leaves the pool unchanged, use clearPoolMembers to remove all members.
+func updatePoolMembers(ctx context.Context, clientV2 *edgecloudV2.Client, pool *edgecloudV2.Pool, members []edgecloudV2.PoolMemberCreateRequest) error {
+	opts := &edgecloudV2.PoolUpdateRequest{Name: pool.Name, Members: members}
+
+	results, _, err := clientV2.Loadbalancers.PoolUpdate(ctx, pool.ID, opts)
+	if err != nil {
+		return err
+	}
+
 	taskID := results.Tasks[0]
 
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBMemberDeleteTimeout)
+	return utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBMemberUpdateTimeout)
+}
+
+// clearPoolMembers removes every member of the pool. Since an empty member list leaves the pool unchanged,
+// all members but one are removed with a pool update and the last one is deleted.
+func clearPoolMembers(ctx context.Context, clientV2 *edgecloudV2.Client, pool *edgecloudV2.Pool) error {
+	if len(pool.Members) == 0 {
+		return nil
+	}
+
+	last := pool.Members[0]
+	if len(pool.Members) > 1 {
+		if err := updatePoolMembers(ctx, clientV2, pool, []edgecloudV2.PoolMemberCreateRequest{poolMemberRequest(last)}); err != nil {
+			return err
+		}
+	}
+
+	return deletePoolMember(ctx, clientV2, pool.ID, last.ID)
+}
+
+// deletePoolMember deletes a member of the pool, a member that no longer exists is ignored.
+func deletePoolMember(ctx context.Context, clientV2 *edgecloudV2.Client, poolID, memberID string) error {
+	results, resp, err := clientV2.Loadbalancers.PoolMemberDelete(ctx, poolID, memberID)
 	if err != nil {
-		return diag.FromErr(err)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
 	}
 
-	d.SetId("")
-	log.Printf("[DEBUG] Finish of LBMember deleting")
+	taskID := results.Tasks[0]
 
-	return diags
+	return utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBMemberDeleteTimeout)
 }
@@ -49,32 +49,36 @@ func resourceLBPool() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -191,6 +195,12 @@ func resourceLBPool() *schema.Resource {
 					},
 				},
 			},
+			"ignore_health_monitor": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Description:   "Leave the health monitor of the pool unmanaged, e.g. when it is managed by 'edgecenter_lb_healthmonitor'. The 'health_monitor' block is then neither sent nor read.",
+				ConflictsWith: []string{"health_monitor"},
+			},
 			"session_persistence": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -256,7 +266,10 @@ func resourceLBPoolCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 	}
 
-	healthOpts := extractHealthMonitorMapV2(d)
+	var healthOpts *edgecloudV2.HealthMonitorCreateRequest
+	if !d.Get("ignore_health_monitor").(bool) {
+		healthOpts = extractHealthMonitorMapV2(d)
+	}
 	sessionOpts := extractSessionPersistenceMapV2(d)
 	opts := edgecloudV2.LoadbalancerPoolCreateRequest{
 		Name:                  d.Get("name").(string),
@@ -377,21 +390,10 @@ func resourceLBPoolRead(ctx context.Context, d *schema.ResourceData, m interface
 		d.Set("listener_id", lb.Listeners[0].ID)
 	}
 
-	if lb.HealthMonitor != nil {
-		healthMonitor := map[string]interface{}{
-			"id":               lb.HealthMonitor.ID,
-			"type":             lb.HealthMonitor.Type,
-			"delay":            lb.HealthMonitor.Delay,
-			"timeout":          lb.HealthMonitor.Timeout,
-			"max_retries":      lb.HealthMonitor.MaxRetries,
-			"max_retries_down": lb.HealthMonitor.MaxRetriesDown,
-			"url_path":         lb.HealthMonitor.URLPath,
-			"expected_codes":   lb.HealthMonitor.ExpectedCodes,
-		}
-		if lb.HealthMonitor.HTTPMethod != nil {
-			healthMonitor["http_method"] = lb.HealthMonitor.HTTPMethod
-		}
-
+	switch healthMonitor := flattenHealthMonitorV2(lb); {
+	case d.Get("ignore_health_monitor").(bool):
+		d.Set("health_monitor", nil)
+	case healthMonitor != nil:
 		if err := d.Set("health_monitor", []interface{}{healthMonitor}); err != nil {
 			return diag.FromErr(err)
 		}
@@ -436,7 +438,7 @@ func resourceLBPoolUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		change = true
 	}
 
-	if d.HasChange("health_monitor") {
+	if d.HasChange("health_monitor") && !d.Get("ignore_health_monitor").(bool) {
 		opts.HealthMonitor = extractHealthMonitorMapV2(d)
 		change = true
 	}
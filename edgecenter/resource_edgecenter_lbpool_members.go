@@ -0,0 +1,315 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const LBPoolMembersMemberField = "member"
+
+func resourceLBPoolMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLBPoolMembersCreate,
+		ReadContext:   resourceLBPoolMembersRead,
+		UpdateContext: resourceLBPoolMembersUpdate,
+		DeleteContext: resourceLBPoolMembersDelete,
+		Description: `Represent the full member set of a load balancer pool. Adds, removes and weight changes are applied in a single pool update.
+The resource is authoritative: members added outside of it, e.g. with 'edgecenter_lbmember', are removed, so do not use both for the same pool.`,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, lbPoolID, err := ImportStringParser(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectID)
+				d.Set("region_id", regionID)
+				d.Set("pool_id", lbPoolID)
+				d.SetId(lbPoolID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
+			},
+			"project_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
+			},
+			"region_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
+			},
+			"pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The uuid for the load balancer pool.",
+				ValidateFunc: validation.IsUUID,
+			},
+			LBPoolMembersMemberField: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The members of the pool. A member is identified by its address and protocol port.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "The IP address of the load balancer pool member.",
+							ValidateDiagFunc: validatePoolMemberAddress,
+						},
+						"protocol_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "The port on which the member listens for requests.",
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"weight": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          1,
+							Description:      "A weight value between 0 and 256, determining the distribution of requests among the members of the pool.",
+							ValidateDiagFunc: validatePoolMemberWeight,
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The uuid of the subnet in which the pool member is located.",
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The uuid of the instance associated with the pool member.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The uuid of the pool member.",
+						},
+						"operating_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current operating status of the pool member.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// poolMemberKey identifies a member of a pool: a pool has one member per address and port.
+func poolMemberKey(address string, protocolPort int) string {
+	return net.JoinHostPort(address, fmt.Sprint(protocolPort))
+}
+
+// expandPoolMembers builds the full member list of the pool from the configured members. A configured
+// member that already exists in the pool keeps its ID, so a weight change updates it in place.
+func expandPoolMembers(raw []interface{}, current []edgecloudV2.PoolMember) []edgecloudV2.PoolMemberCreateRequest {
+	currentIDs := make(map[string]string, len(current))
+	for _, pm := range current {
+		currentIDs[poolMemberKey(pm.Address.String(), pm.ProtocolPort)] = pm.ID
+	}
+
+	members := make([]edgecloudV2.PoolMemberCreateRequest, 0, len(raw))
+	for _, item := range raw {
+		member := item.(map[string]interface{})
+		address := net.ParseIP(member["address"].(string))
+		protocolPort := member["protocol_port"].(int)
+		members = append(members, edgecloudV2.PoolMemberCreateRequest{
+			Address:      address,
+			ProtocolPort: protocolPort,
+			Weight:       member["weight"].(int),
+			SubnetID:     member["subnet_id"].(string),
+			InstanceID:   member["instance_id"].(string),
+			ID:           currentIDs[poolMemberKey(address.String(), protocolPort)],
+		})
+	}
+
+	return members
+}
+
+// flattenPoolMembers returns every member of the pool, including the ones added outside of Terraform.
+// The subnet and the instance are left empty when they are not set in the known members, since
+// the API fills them in.
+func flattenPoolMembers(members []edgecloudV2.PoolMember, known []interface{}) []interface{} {
+	knownMembers := make(map[string]map[string]interface{}, len(known))
+	for _, item := range known {
+		member := item.(map[string]interface{})
+		knownMembers[poolMemberKey(member["address"].(string), member["protocol_port"].(int))] = member
+	}
+
+	result := make([]interface{}, 0, len(members))
+	for _, pm := range members {
+		member := map[string]interface{}{
+			"address":          pm.Address.String(),
+			"protocol_port":    pm.ProtocolPort,
+			"weight":           pm.Weight,
+			"subnet_id":        pm.SubnetID,
+			"instance_id":      pm.InstanceID,
+			"id":               pm.ID,
+			"operating_status": string(pm.OperatingStatus),
+		}
+		if k, ok := knownMembers[poolMemberKey(pm.Address.String(), pm.ProtocolPort)]; ok {
+			for _, field := range []string{"subnet_id", "instance_id"} {
+				if k[field].(string) == "" {
+					member[field] = ""
+				}
+			}
+		}
+		result = append(result, member)
+	}
+
+	return result
+}
+
+// applyPoolMembers makes the configured members the full member set of the pool with a single pool update.
+func applyPoolMembers(ctx context.Context, clientV2 *edgecloudV2.Client, pool *edgecloudV2.Pool, raw []interface{}) error {
+	members := expandPoolMembers(raw, pool.Members)
+	if len(members) == 0 {
+		return clearPoolMembers(ctx, clientV2, pool)
+	}
+
+	return updatePoolMembers(ctx, clientV2, pool, members)
+}
+
+func resourceLBPoolMembersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LBPoolMembers creating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	pool, _, err := clientV2.Loadbalancers.PoolGet(ctx, poolID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := applyPoolMembers(ctx, clientV2, pool, d.Get(LBPoolMembersMemberField).(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(poolID)
+	log.Printf("[DEBUG] Finish LBPoolMembers creating (%s)", poolID)
+
+	return resourceLBPoolMembersRead(ctx, d, m)
+}
+
+func resourceLBPoolMembersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LBPoolMembers reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pool, resp, err := clientV2.Loadbalancers.PoolGet(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing members of pool %s from state because the pool doesn't exist anymore", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("pool_id", pool.ID)
+	members := flattenPoolMembers(pool.Members, d.Get(LBPoolMembersMemberField).(*schema.Set).List())
+	if err := d.Set(LBPoolMembersMemberField, members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	fields := []string{"project_id", "region_id"}
+	revertState(d, &fields)
+
+	log.Println("[DEBUG] Finish LBPoolMembers reading")
+
+	return nil
+}
+
+func resourceLBPoolMembersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LBPoolMembers updating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(LBPoolMembersMemberField) {
+		pool, _, err := clientV2.Loadbalancers.PoolGet(ctx, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := applyPoolMembers(ctx, clientV2, pool, d.Get(LBPoolMembersMemberField).(*schema.Set).List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Println("[DEBUG] Finish LBPoolMembers updating")
+
+	return resourceLBPoolMembersRead(ctx, d, m)
+}
+
+func resourceLBPoolMembersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LBPoolMembers deleting")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pool, resp, err := clientV2.Loadbalancers.PoolGet(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := clearPoolMembers(ctx, clientV2, pool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	log.Println("[DEBUG] Finish of LBPoolMembers deleting")
+
+	return nil
+}
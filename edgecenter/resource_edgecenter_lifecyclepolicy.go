@@ -7,8 +7,10 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -17,6 +19,11 @@ import (
 
 const (
 	LifecyclePolicyPoint = "lifecycle_policies"
+
+	LifecyclePolicyCreateTimeout = 1200 * time.Second
+	LifecyclePolicyUpdateTimeout = 1200 * time.Second
+	LifecyclePolicyDeleteTimeout = 1200 * time.Second
+
 	// Maybe move to utils and use for other resources.
 	nameRegexString = `^[a-zA-Z0-9][a-zA-Z 0-9._\-]{1,61}[a-zA-Z0-9._]$`
 )
@@ -30,7 +37,16 @@ func resourceLifecyclePolicy() *schema.Resource {
 		ReadContext:   resourceLifecyclePolicyRead,
 		UpdateContext: resourceLifecyclePolicyUpdate,
 		DeleteContext: resourceLifecyclePolicyDelete,
-		Description:   "Represent lifecycle policy. Use to periodically take snapshots",
+		CustomizeDiff: customdiff.All(lifecyclePolicyScheduleDiff, lifecyclePolicyInstancesDiff),
+		Description: `Represent lifecycle policy. Use to periodically take snapshots.
+The policy takes snapshots of the listed volumes and of all the volumes attached to the targeted instances. The volumes of an instance are snapshotted by the same schedule runs, but their snapshots are not guaranteed to be consistent with each other.
+The API only offers the 'volume_snapshot' action, so a policy cannot produce images.
+Instances are targeted by ID and by a metadata selector, which are resolved to their volumes on every plan.`,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(LifecyclePolicyCreateTimeout),
+			Update: schema.DefaultTimeout(LifecyclePolicyUpdateTimeout),
+			Delete: schema.DefaultTimeout(LifecyclePolicyDeleteTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, lcpID, err := ImportStringParser(d.Id())
@@ -47,32 +63,36 @@ func resourceLifecyclePolicy() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -90,6 +110,7 @@ func resourceLifecyclePolicy() *schema.Resource {
 				Optional:     true,
 				Default:      edgecloudV2.LifeCyclePolicyActionVolumeSnapshot.String(),
 				ForceNew:     true,
+				Description:  "The action of the policy. Only 'volume_snapshot' is available.",
 				ValidateFunc: validation.StringInSlice(edgecloudV2.LifeCyclePolicyAction("").StringList(), false),
 			},
 			"volume": {
@@ -110,6 +131,39 @@ func resourceLifecyclePolicy() *schema.Resource {
 					},
 				},
 			},
+			"instance_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The uuids of the instances whose attached volumes are managed by the policy.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+			"instance_selector": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Selects the instances whose attached volumes are managed by the policy by their metadata. The instances are looked up on every plan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata_kv": {
+							Type:        schema.TypeMap,
+							Required:    true,
+							Description: "The metadata key-value pairs an instance must have, for example, {backup = \"daily\"}.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"instance_volume_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The uuids of the volumes attached to the targeted instances.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"schedule": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -168,6 +222,8 @@ func resourceLifecyclePolicy() *schema.Resource {
 										Type:             schema.TypeString,
 										Optional:         true,
 										Default:          "UTC",
+										ValidateFunc:     validateTimezone,
+										Description:      "The IANA time zone of the other fields, e.g. 'Europe/Amsterdam'.",
 										DiffSuppressFunc: suppressEquivalentCronDiffs,
 									},
 									"month": {
@@ -278,6 +334,13 @@ func resourceLifecyclePolicy() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			LifecyclePolicyNextRunsField: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: fmt.Sprintf("The next %d runs of the cron schedules in RFC 3339 format, in the time zone of each schedule. "+
+					"The runs of the interval schedules depend on when the service started them, so they are not included.", LifecyclePolicyNextRunsCount),
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -285,6 +348,9 @@ func resourceLifecyclePolicy() *schema.Resource {
 func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Start of LifecyclePolicy creating")
 
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
 	if len(d.Get("schedule").([]interface{})) == 0 {
 		return diag.Errorf("at least one 'schedule' should be set")
 	}
@@ -293,7 +359,11 @@ func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	opts, err := buildLifecyclePolicyCreateOptsV2(d)
+	instanceVolumeIDs, err := lifecyclePolicyInstanceVolumes(ctx, clientV2, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opts, err := buildLifecyclePolicyCreateOptsV2(d, instanceVolumeIDs)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -302,6 +372,7 @@ func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating lifecycle policy: %s", err)
 	}
 	d.SetId(strconv.Itoa(policy.ID))
+	_ = d.Set("instance_volume_ids", instanceVolumeIDs)
 	log.Printf("[DEBUG] Finish of LifecyclePolicy %s creating", d.Id())
 
 	return resourceLifecyclePolicyRead(ctx, d, m)
@@ -331,12 +402,18 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, m
 	_ = d.Set("status", policy.Status)
 	_ = d.Set("action", policy.Action)
 	_ = d.Set("user_id", policy.UserID)
-	if err = d.Set("volume", flattenVolumesV2(policy.Volumes)); err != nil {
+	volumes, instanceVolumeIDs := splitLifecyclePolicyVolumes(
+		policy.Volumes, d.Get("volume").(*schema.Set), d.Get("instance_volume_ids").(*schema.Set))
+	if err = d.Set("volume", flattenVolumesV2(volumes)); err != nil {
 		return diag.Errorf("error setting lifecycle policy volumes: %s", err)
 	}
+	if err = d.Set("instance_volume_ids", instanceVolumeIDs); err != nil {
+		return diag.Errorf("error setting lifecycle policy instance volumes: %s", err)
+	}
 	if err = d.Set("schedule", flattenSchedulesV2(policy.Schedules)); err != nil {
 		return diag.Errorf("error setting lifecycle policy schedules: %s", err)
 	}
+	setLifecyclePolicyNextRuns(d)
 
 	log.Printf("[DEBUG] Finish of LifecyclePolicy %s reading", id)
 
@@ -344,6 +421,9 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
 	clientV2, err := InitCloudClient(ctx, d, m, nil)
 	if err != nil {
 		return diag.FromErr(err)
@@ -364,17 +444,30 @@ func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("volume") {
-		oldVolumes, newVolumes := d.GetChange("volume")
-		toRemove, toAdd := volumeSymmetricDifference(oldVolumes.(*schema.Set), newVolumes.(*schema.Set))
-		_, _, err = clientV2.LifeCyclePolicies.RemoveVolumes(ctx, integerID, &edgecloudV2.LifeCyclePolicyRemoveVolumesRequest{VolumeIds: toRemove})
+	if d.HasChanges("volume", "instance_ids", "instance_selector", "instance_volume_ids") {
+		instanceVolumeIDs, err := lifecyclePolicyInstanceVolumes(ctx, clientV2, d)
 		if err != nil {
-			return diag.Errorf("Error removing volumes from lifecycle policy: %s", err)
+			return diag.FromErr(err)
 		}
-		_, _, err = clientV2.LifeCyclePolicies.AddVolumes(ctx, integerID, &edgecloudV2.LifeCyclePolicyAddVolumesRequest{VolumeIds: toAdd})
-		if err != nil {
-			return diag.Errorf("Error adding volumes to lifecycle policy: %s", err)
+
+		oldVolumes, _ := d.GetChange("volume")
+		oldInstanceVolumes, _ := d.GetChange("instance_volume_ids")
+		oldIDs := lifecyclePolicyVolumeIDs(oldVolumes.(*schema.Set), expandStringSet(oldInstanceVolumes.(*schema.Set)))
+		newIDs := lifecyclePolicyVolumeIDs(d.Get("volume").(*schema.Set), instanceVolumeIDs)
+		toRemove, toAdd := stringSymmetricDifference(oldIDs, newIDs)
+		if len(toRemove) > 0 {
+			_, _, err = clientV2.LifeCyclePolicies.RemoveVolumes(ctx, integerID, &edgecloudV2.LifeCyclePolicyRemoveVolumesRequest{VolumeIds: toRemove})
+			if err != nil {
+				return diag.Errorf("Error removing volumes from lifecycle policy: %s", err)
+			}
 		}
+		if len(toAdd) > 0 {
+			_, _, err = clientV2.LifeCyclePolicies.AddVolumes(ctx, integerID, &edgecloudV2.LifeCyclePolicyAddVolumesRequest{VolumeIds: toAdd})
+			if err != nil {
+				return diag.Errorf("Error adding volumes to lifecycle policy: %s", err)
+			}
+		}
+		_ = d.Set("instance_volume_ids", instanceVolumeIDs)
 	}
 
 	if d.HasChange("schedule") {
@@ -416,6 +509,9 @@ func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceLifecyclePolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
 	clientV2, err := InitCloudClient(ctx, d, m, nil)
 	if err != nil {
 		return diag.FromErr(err)
@@ -530,7 +626,7 @@ func expandVolumeIds(flat []interface{}) []string {
 	return expanded
 }
 
-func buildLifecyclePolicyCreateOptsV2(d *schema.ResourceData) (*edgecloudV2.LifeCyclePolicyCreateRequest, error) {
+func buildLifecyclePolicyCreateOptsV2(d *schema.ResourceData, instanceVolumeIDs []string) (*edgecloudV2.LifeCyclePolicyCreateRequest, error) {
 	schedules, err := expandSchedulesV2(d.Get("schedule").([]interface{}))
 	if err != nil {
 		return nil, err
@@ -539,7 +635,7 @@ func buildLifecyclePolicyCreateOptsV2(d *schema.ResourceData) (*edgecloudV2.Life
 		Name:      d.Get("name").(string),
 		Status:    edgecloudV2.LifeCyclePolicyStatus(d.Get("status").(string)),
 		Schedules: schedules,
-		VolumeIds: expandVolumeIds(d.Get("volume").(*schema.Set).List()),
+		VolumeIds: lifecyclePolicyVolumeIDs(d.Get("volume").(*schema.Set), instanceVolumeIDs),
 	}
 
 	// Action is required field from API point of view, but optional for us
@@ -552,23 +648,6 @@ func buildLifecyclePolicyCreateOptsV2(d *schema.ResourceData) (*edgecloudV2.Life
 	return opts, nil
 }
 
-func volumeSymmetricDifference(oldVolumes, newVolumes *schema.Set) ([]string, []string) {
-	toRemove := make([]string, 0)
-	for _, v := range oldVolumes.List() {
-		if !newVolumes.Contains(v) {
-			toRemove = append(toRemove, v.(map[string]interface{})["id"].(string))
-		}
-	}
-	toAdd := make([]string, 0)
-	for _, v := range newVolumes.List() {
-		if !oldVolumes.Contains(v) {
-			toAdd = append(toAdd, v.(map[string]interface{})["id"].(string))
-		}
-	}
-
-	return toRemove, toAdd
-}
-
 func buildLifecyclePolicyUpdateOptsV2(d *schema.ResourceData) edgecloudV2.LifeCyclePolicyUpdateRequest {
 	opts := edgecloudV2.LifeCyclePolicyUpdateRequest{
 		Name:   d.Get("name").(string),
@@ -679,6 +758,11 @@ func validateDaysOfWeek(v interface{}, k string) ([]string, []error) {
 		return nil, nil
 	}
 
+	if len(days) == 0 {
+		errors = append(errors, fmt.Errorf("%s must not be empty. Use '*' for any day", k))
+		return nil, errors
+	}
+
 	if len(days) > 7 {
 		errors = append(errors, fmt.Errorf("too many days specified: %d. Maximum allowed is 7 days", len(days)))
 		return nil, errors
@@ -714,6 +798,19 @@ func validateDaysOfWeek(v interface{}, k string) ([]string, []error) {
 	return nil, errors
 }
 
+func validateTimezone(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := loadLifecyclePolicyTimezone(value); err != nil {
+		return nil, []error{err}
+	}
+
+	return nil, nil
+}
+
 func validateCronField(min, max int) func(v interface{}, k string) ([]string, []error) {
 	return func(v interface{}, k string) ([]string, []error) {
 		var errors []error
@@ -729,6 +826,10 @@ func validateCronField(min, max int) func(v interface{}, k string) ([]string, []
 		}
 
 		fields := splitByCommaOrSpace(value)
+		if len(fields) == 0 {
+			errors = append(errors, fmt.Errorf("%s must not be empty. Use '*' for any value", k))
+			return nil, errors
+		}
 
 		for _, field := range fields {
 			num, err := strconv.Atoi(field)
@@ -29,6 +29,7 @@ func resourceLoadBalancer() *schema.Resource {
 		ReadContext:        resourceLoadBalancerRead,
 		UpdateContext:      resourceLoadBalancerUpdate,
 		DeleteContext:      resourceLoadBalancerDelete,
+		CustomizeDiff:      cloudMetadata.customizeDiff,
 		Description:        "Represent load balancer. **WARNING:** Resource \"loadbalancer\" is deprecated.\n Use edgecenter_loadbalancerv2 resource instead.\n The v2migrate tool converts the project to V2 without recreating resources, see the [v1 to v2 migration guide](https://registry.terraform.io/providers/Edge-Center/edgecenter/latest/docs/guides/v1-to-v2-migration).",
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -69,32 +70,36 @@ func resourceLoadBalancer() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -195,6 +200,7 @@ func resourceLoadBalancer() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			MetadataAllField: metadataAllSchema(),
 			"metadata_read_only": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -278,7 +284,7 @@ func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, m int
 
 	metadataMap, metadataReadOnly := PrepareMetadata(lb.MetadataDetailed)
 
-	if err = d.Set("metadata_map", metadataMap); err != nil {
+	if err = cloudMetadata.setFromAPI(d, m, metadataMap); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -378,15 +384,8 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
-	if d.HasChange("metadata_map") {
-		_, nmd := d.GetChange("metadata_map")
-
-		meta, err := MapInterfaceToMapString(nmd.(map[string]interface{}))
-		if err != nil {
-			return diag.Errorf("cannot get metadata. Error: %s", err)
-		}
-
-		metadataLB := edgecloudV2.Metadata(*meta)
+	if cloudMetadata.hasChange(d) {
+		metadataLB := edgecloudV2.Metadata(cloudMetadata.merged(d, m))
 		_, err = clientV2.Loadbalancers.MetadataUpdate(ctx, d.Id(), &metadataLB)
 		if err != nil {
 			return diag.Errorf("cannot update metadata. Error: %s", err)
@@ -2,12 +2,14 @@ package edgecenter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
@@ -20,7 +22,7 @@ func resourceLoadBalancerV2() *schema.Resource {
 		ReadContext:   resourceLoadBalancerV2Read,
 		UpdateContext: resourceLoadBalancerV2Update,
 		DeleteContext: resourceLoadBalancerV2Delete,
-		CustomizeDiff: customLoadBalancerV2Diff,
+		CustomizeDiff: customdiff.All(customLoadBalancerV2Diff, cloudMetadata.customizeDiff, loadBalancerV2QuotaDiff),
 		Description:   "Represent load balancer without nested listener",
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(LoadBalancerCreateTimeout),
@@ -43,32 +45,36 @@ func resourceLoadBalancerV2() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -121,6 +127,7 @@ func resourceLoadBalancerV2() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			MetadataAllField: metadataAllSchema(),
 			"metadata_read_only": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -171,6 +178,26 @@ func customLoadBalancerV2Diff(_ context.Context, d *schema.ResourceDiff, _ inter
 	return nil
 }
 
+// moveLoadBalancerStateToV2 rewrites the state of an edgecenter_loadbalancer for edgecenter_loadbalancerv2.
+// The attributes keep their names, the listener is managed by its own edgecenter_lblistener resource.
+// The create time only vip_network_id and vip_subnet_id are dropped, v2migrate comments them out
+// of the configuration because they are ForceNew in V2.
+func moveLoadBalancerStateToV2(source map[string]interface{}) (map[string]interface{}, error) {
+	if id, _ := source[IDField].(string); id == "" {
+		return nil, errors.New("the edgecenter_loadbalancer state has no id")
+	}
+
+	target := make(map[string]interface{}, len(source))
+	for key, v := range source {
+		target[key] = v
+	}
+	for _, key := range []string{"listener", "vip_network_id", "vip_subnet_id"} {
+		delete(target, key)
+	}
+
+	return target, nil
+}
+
 func resourceLoadBalancerV2Create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("[DEBUG] Start LoadBalancer creating")
 	var diags diag.Diagnostics
@@ -187,12 +214,8 @@ func resourceLoadBalancerV2Create(ctx context.Context, d *schema.ResourceData, m
 		VipSubnetID:  d.Get("vip_subnet_id").(string),
 	}
 
-	if metadataRaw, ok := d.GetOk("metadata_map"); ok {
-		meta, err := MapInterfaceToMapString(metadataRaw)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		opts.Metadata = *meta
+	if metadata := cloudMetadata.merged(d, m); len(metadata) > 0 {
+		opts.Metadata = metadata
 	}
 
 	lbFlavor := d.Get("flavor").(string)
@@ -256,7 +279,7 @@ func resourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, m i
 
 	metadataMap, metadataReadOnly := PrepareMetadata(metadataList)
 
-	if err = d.Set("metadata_map", metadataMap); err != nil {
+	if err = cloudMetadata.setFromAPI(d, m, metadataMap); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -289,15 +312,8 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 		d.Set("last_updated", time.Now().Format(time.RFC850))
 	}
 
-	if d.HasChange("metadata_map") {
-		_, nmd := d.GetChange("metadata_map")
-
-		meta, err := MapInterfaceToMapString(nmd.(map[string]interface{}))
-		if err != nil {
-			return diag.Errorf("cannot get metadata. Error: %s", err)
-		}
-
-		metadataLB := edgecloudV2.Metadata(*meta)
+	if cloudMetadata.hasChange(d) {
+		metadataLB := edgecloudV2.Metadata(cloudMetadata.merged(d, m))
 		_, err = clientV2.Loadbalancers.MetadataUpdate(ctx, d.Id(), &metadataLB)
 		if err != nil {
 			return diag.Errorf("cannot update metadata. Error: %s", err)
@@ -375,3 +391,14 @@ func resourceLoadBalancerV2Delete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// loadBalancerV2QuotaDiff checks the quota demand of a new load balancer.
+func loadBalancerV2QuotaDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	return CheckQuotaDemand(ctx, d, m, func(context.Context, *edgecloudV2.Client) (QuotaDemand, error) {
+		return QuotaDemand{QuotaLoadbalancerCount: 1}, nil
+	})
+}
@@ -30,7 +30,12 @@ func resourceNetwork() *schema.Resource {
 		ReadContext:   resourceNetworkRead,
 		UpdateContext: resourceNetworkUpdate,
 		DeleteContext: resourceNetworkDelete,
+		CustomizeDiff: cloudMetadata.customizeDiff,
 		Description:   "Represent network. A network is a software-defined network in a cloud computing infrastructure",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(NetworkCreatingTimeout),
+			Delete: schema.DefaultTimeout(NetworkDeletingTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, NetworkID, err := ImportStringParser(d.Id())
@@ -47,28 +52,32 @@ func resourceNetwork() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -108,6 +117,7 @@ func resourceNetwork() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			MetadataAllField: metadataAllSchema(),
 			"metadata_read_only": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -149,13 +159,8 @@ func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interf
 		CreateRouter: d.Get("create_router").(bool),
 	}
 
-	if metadataRaw, ok := d.GetOk("metadata_map"); ok {
-		meta, err := MapInterfaceToMapString(metadataRaw)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		createOpts.Metadata = *meta
+	if metadata := cloudMetadata.merged(d, m); len(metadata) > 0 {
+		createOpts.Metadata = metadata
 	}
 
 	log.Printf("Create network ops: %+v", createOpts)
@@ -168,7 +173,7 @@ func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interf
 	taskID := results.Tasks[0]
 	log.Printf("[DEBUG] Task id (%s)", taskID)
 
-	taskInfo, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, NetworkCreatingTimeout)
+	taskInfo, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -217,7 +222,7 @@ func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	metadataMap, metadataReadOnly := PrepareMetadata(network.Metadata)
 
-	if err = d.Set("metadata_map", metadataMap); err != nil {
+	if err = cloudMetadata.setFromAPI(d, m, metadataMap); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -253,15 +258,9 @@ func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 
-	if d.HasChange("metadata_map") {
-		_, nmd := d.GetChange("metadata_map")
-
-		meta, err := MapInterfaceToMapString(nmd.(map[string]interface{}))
-		if err != nil {
-			return diag.Errorf("cannot get metadata. Error: %s", err)
-		}
-
-		_, err = clientV2.Networks.MetadataUpdate(ctx, networkID, (*edgecloudV2.Metadata)(meta))
+	if cloudMetadata.hasChange(d) {
+		metadata := edgecloudV2.Metadata(cloudMetadata.merged(d, m))
+		_, err = clientV2.Networks.MetadataUpdate(ctx, networkID, &metadata)
 		if err != nil {
 			return diag.Errorf("cannot update metadata. Error: %s", err)
 		}
@@ -291,7 +290,7 @@ func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, m interf
 	taskID := results.Tasks[0]
 	log.Printf("[DEBUG] Task id (%s)", taskID)
 
-	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, NetworkDeletingTimeout)
+	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
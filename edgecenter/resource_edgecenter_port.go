@@ -0,0 +1,363 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+const (
+	PortCreateTimeout = 1200 * time.Second
+	PortUpdateTimeout = 1200 * time.Second
+	PortDeleteTimeout = 1200 * time.Second
+)
+
+func resourcePort() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePortCreate,
+		ReadContext:   resourcePortRead,
+		UpdateContext: resourcePortUpdate,
+		DeleteContext: resourcePortDelete,
+		Description: `Represent a network port, e.g. for a VRRP address or an appliance instance.
+Attach it to an instance with an 'edgecenter_instanceV2' interface of the 'reserved_fixed_ip' type.
+Security groups are assigned through the instance, so they apply once the port is attached to an instance.`,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(PortCreateTimeout),
+			Update: schema.DefaultTimeout(PortUpdateTimeout),
+			Delete: schema.DefaultTimeout(PortDeleteTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, portID, err := ImportStringParser(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectID)
+				d.Set("region_id", regionID)
+				d.SetId(portID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
+			},
+			"project_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
+			},
+			"region_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
+			},
+			"network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The uuid of the network of the port. Either 'network_id' or 'subnet_id' must be specified.",
+				AtLeastOneOf: []string{"network_id", "subnet_id"},
+			},
+			"subnet_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the subnet to allocate the fixed IP address of the port from. If not set, any subnet of the network is used.",
+				ConflictsWith: []string{"fixed_ip_address"},
+			},
+			"fixed_ip_address": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The fixed IP address of the port. Requires 'network_id', allocated automatically if not set.",
+				RequiredWith:  []string{"network_id"},
+				ConflictsWith: []string{"subnet_id"},
+				ValidateFunc:  validation.IsIPAddress,
+			},
+			"allowed_address_pairs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The IP addresses, e.g. a VRRP address, that are allowed to send traffic through the port besides its own. Requires port security.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The IP address or the CIDR.",
+							ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The MAC address. Defaults to the MAC address of the port.",
+						},
+					},
+				},
+			},
+			PortSecurityDisabledField: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the port security is disabled. The port security is required for security groups and allowed address pairs.",
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Description: "The uuids of the security groups of the port. They are assigned once the port is attached to an instance. " +
+					"If not set, the groups the port gets from the instance are kept.",
+				Set:  schema.HashString,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uuid of the instance the port is attached to.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the port.",
+			},
+		},
+	}
+}
+
+// portDetachedSecurityGroupsWarning warns that the security groups of the port are assigned later.
+func portDetachedSecurityGroupsWarning(portID string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Security groups of the port are not assigned yet",
+		Detail:   fmt.Sprintf("Port %s is not attached to an instance. Its security groups will be assigned by the next apply after it is attached to an instance.", portID),
+	}
+}
+
+func resourcePortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Port creating")
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	networkID := d.Get("network_id").(string)
+	subnetID := d.Get("subnet_id").(string)
+	fixedIPAddress := d.Get("fixed_ip_address").(string)
+
+	portType, err := portReservedFixedIPType(networkID, subnetID, fixedIPAddress)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := &edgecloudV2.ReservedFixedIPCreateRequest{
+		Type:      portType,
+		NetworkID: networkID,
+		SubnetID:  subnetID,
+		IPAddress: fixedIPAddress,
+	}
+	if portType == edgecloudV2.ReservedFixedIPTypeSubnet {
+		opts.NetworkID = ""
+	}
+
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.ReservedFixedIP.Create, opts, clientV2, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error port creating: %s", err)
+	}
+	portID := taskResult.Ports[0]
+	d.SetId(portID)
+
+	if d.Get(PortSecurityDisabledField).(bool) {
+		if _, _, err := clientV2.Ports.DisablePortSecurity(ctx, portID); err != nil {
+			return diag.Errorf("cannot disable port security of port %s: %s", portID, err)
+		}
+	}
+
+	if pairs := d.Get("allowed_address_pairs").([]interface{}); len(pairs) > 0 {
+		if err := assignAllowedAddressPairs(ctx, clientV2, portID, pairs); err != nil {
+			return diag.Errorf("cannot assign allowed address pairs to port %s: %s", portID, err)
+		}
+	}
+
+	if d.Get("security_group_ids").(*schema.Set).Len() > 0 {
+		diags = append(diags, portDetachedSecurityGroupsWarning(portID))
+	}
+
+	log.Printf("[DEBUG] Finish Port creating (%s)", portID)
+
+	return append(diags, resourcePortRead(ctx, d, m)...)
+}
+
+func resourcePortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Port reading")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	port, resp, err := clientV2.ReservedFixedIP.Get(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing port %s because resource doesn't exist anymore", d.Id())
+			d.SetId("")
+
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	d.Set("project_id", port.ProjectID)
+	d.Set("region_id", port.RegionID)
+	d.Set("network_id", port.NetworkID)
+	d.Set("subnet_id", port.SubnetID)
+	d.Set("fixed_ip_address", port.FixedIPAddress.String())
+	d.Set("status", port.Status)
+	if err := d.Set("allowed_address_pairs", flattenAllowedAddressPairs(port)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The port security and the security groups can only be read through the instance,
+	// the values of a detached port are kept as they are.
+	instanceID := portInstanceID(port)
+	d.Set("instance_id", instanceID)
+	if instanceID != "" {
+		portSecurityEnabled, sgIDs, err := portInstanceSecurity(ctx, clientV2, instanceID, port.PortID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set(PortSecurityDisabledField, !portSecurityEnabled)
+		if err := d.Set("security_group_ids", schema.NewSet(schema.HashString, sgIDs)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Println("[DEBUG] Finish Port reading")
+
+	return diags
+}
+
+func resourcePortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Port updating")
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	portID := d.Id()
+
+	if d.HasChange(PortSecurityDisabledField) {
+		if d.Get(PortSecurityDisabledField).(bool) {
+			_, _, err = clientV2.Ports.DisablePortSecurity(ctx, portID)
+		} else {
+			_, _, err = clientV2.Ports.EnablePortSecurity(ctx, portID)
+		}
+		if err != nil {
+			return diag.Errorf("cannot switch port security of port %s: %s", portID, err)
+		}
+	}
+
+	if d.HasChange("allowed_address_pairs") {
+		if err := assignAllowedAddressPairs(ctx, clientV2, portID, d.Get("allowed_address_pairs").([]interface{})); err != nil {
+			return diag.Errorf("cannot assign allowed address pairs to port %s: %s", portID, err)
+		}
+	}
+
+	if d.HasChange("security_group_ids") {
+		port, _, err := clientV2.ReservedFixedIP.Get(ctx, portID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if instanceID := portInstanceID(port); instanceID != "" {
+			oldSGs, newSGs := d.GetChange("security_group_ids")
+			if err := updatePortSecurityGroups(ctx, clientV2, instanceID, portID, oldSGs.(*schema.Set), newSGs.(*schema.Set)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			diags = append(diags, portDetachedSecurityGroupsWarning(portID))
+		}
+	}
+
+	log.Println("[DEBUG] Finish Port updating")
+
+	return append(diags, resourcePortRead(ctx, d, m)...)
+}
+
+func resourcePortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Port deleting")
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	portID := d.Id()
+	results, resp, err := clientV2.ReservedFixedIP.Delete(ctx, portID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if err := utilV2.WaitForTaskComplete(ctx, clientV2, results.Tasks[0], d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	log.Printf("[DEBUG] Finish of Port deleting")
+
+	return diags
+}
@@ -25,6 +25,10 @@ func resourceRouter() *schema.Resource {
 		UpdateContext: resourceRouterUpdate,
 		DeleteContext: resourceRouterDelete,
 		Description:   "Represent router. Router enables you to dynamically exchange routes between networks",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(RouterCreatingTimeout),
+			Delete: schema.DefaultTimeout(RouterDeletingTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, routerID, err := ImportStringParser(d.Id())
@@ -41,28 +45,32 @@ func resourceRouter() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -155,6 +163,16 @@ func resourceRouter() *schema.Resource {
 				Description: "Set of static routes to be applied to the router.",
 				Elem:        HostRouteSchema(true),
 			},
+			"non_exclusive_interfaces": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to true to manage only the interfaces listed in 'interfaces' and ignore the other interfaces of the router, e.g. attached by 'edgecenter_router_interface'.",
+			},
+			"non_exclusive_routes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to true to manage only the routes listed in 'routes' and keep the other routes of the router, e.g. added by 'edgecenter_router_route'.",
+			},
 			"last_updated": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -209,7 +227,7 @@ func resourceRouterCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	log.Printf("[DEBUG] Router create options: %+v", createOpts)
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Routers.Create, &createOpts, clientV2, RouterCreatingTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Routers.Create, &createOpts, clientV2, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.Errorf("error router creating: %s", err)
 	}
@@ -274,29 +292,23 @@ func resourceRouterRead(ctx context.Context, d *schema.ResourceData, m interface
 		d.Set("external_gateway_info", egilst)
 	}
 
-	ifs := make([]interface{}, 0, len(router.Interfaces))
-	for _, iface := range router.Interfaces {
-		for _, subnet := range iface.IPAssignments {
-			smap := make(map[string]interface{}, 6)
-			smap["port_id"] = iface.PortID
-			smap["network_id"] = iface.NetworkID
-			smap["mac_address"] = iface.MacAddress
-			smap["type"] = "subnet"
-			smap["subnet_id"] = subnet.SubnetID
-			smap["ip_address"] = subnet.IPAddress.String()
-			ifs = append(ifs, smap)
-		}
+	ifs := flattenRouterInterfaces(router)
+	if d.Get("non_exclusive_interfaces").(bool) {
+		ifs = managedRouterInterfaces(d.Get("interfaces").(*schema.Set), ifs)
 	}
 	if err := d.Set("interfaces", schema.NewSet(routerInterfaceUniqueID, ifs)); err != nil {
 		return diag.FromErr(err)
 	}
 
-	rs := make([]map[string]string, len(router.Routes))
-	for i, r := range router.Routes {
-		rmap := make(map[string]string, 2)
+	rs := make([]interface{}, 0, len(router.Routes))
+	for _, r := range router.Routes {
+		rmap := make(map[string]interface{}, 2)
 		rmap["destination"] = r.Destination.String()
 		rmap["nexthop"] = r.NextHop.String()
-		rs[i] = rmap
+		rs = append(rs, rmap)
+	}
+	if d.Get("non_exclusive_routes").(bool) {
+		rs = managedRouterRoutes(d.Get("routes").(*schema.Set), rs)
 	}
 	d.Set("routes", rs)
 
@@ -315,6 +327,9 @@ func resourceRouterUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
+	routerLocks.Lock(routerID)
+	defer routerLocks.Unlock(routerID)
+
 	updateOpts := edgecloudV2.RouterUpdateRequest{}
 
 	updateOpts.Name = d.Get("name").(string)
@@ -382,6 +397,19 @@ func resourceRouterUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		updateOpts.Routes = routes
 	}
 
+	if d.Get("non_exclusive_routes").(bool) {
+		router, _, err := clientV2.Routers.Get(ctx, routerID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		oldRoutes, _ := d.GetChange("routes")
+		removed, err := extractHostRoutesMapV2(oldRoutes.(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		updateOpts.Routes = mergeRouterRoutes(router.Routes, removed, updateOpts.Routes)
+	}
+
 	if d.HasChange("routes") || d.HasChange("external_gateway_info") || d.HasChange("name") {
 		_, _, err = clientV2.Routers.Update(ctx, routerID, &updateOpts)
 		if err != nil {
@@ -411,7 +439,7 @@ func resourceRouterDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 	taskID := results.Tasks[0]
 	log.Printf("[DEBUG] Task id (%s)", taskID)
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, RouterDeletingTimeout)
+	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,234 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	RouterInterfaceCreateTimeout = 1200 * time.Second
+	RouterInterfaceDeleteTimeout = 1200 * time.Second
+)
+
+func resourceRouterInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRouterInterfaceCreate,
+		ReadContext:   resourceRouterInterfaceRead,
+		DeleteContext: resourceRouterInterfaceDelete,
+		Description: "Attaches a subnet to a router. Set 'non_exclusive_interfaces' on the " +
+			"'edgecenter_router' resource of the router, so it keeps the interface.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(RouterInterfaceCreateTimeout),
+			Delete: schema.DefaultTimeout(RouterInterfaceDeleteTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, routerID, subnetID, err := ImportStringParserExtended(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectID)
+				d.Set("region_id", regionID)
+				d.Set("router_id", routerID)
+				d.Set("subnet_id", subnetID)
+				d.SetId(routerInterfaceID(routerID, subnetID))
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
+			},
+			"project_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
+			},
+			"region_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
+			},
+			"router_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The uuid of the router.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The uuid of the subnet to attach to the router. The subnet must have a gateway IP.",
+			},
+			"port_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uuid of the port of the router in the subnet.",
+			},
+			"network_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uuid of the network of the subnet.",
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MAC address of the port of the router in the subnet.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address of the router in the subnet.",
+			},
+		},
+	}
+}
+
+// routerInterfaceID returns the id of the interface of the router in the subnet.
+func routerInterfaceID(routerID, subnetID string) string {
+	return routerID + ":" + subnetID
+}
+
+func resourceRouterInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start RouterInterface creating")
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("region_id", clientV2.Region)
+	d.Set("project_id", clientV2.Project)
+
+	routerID := d.Get("router_id").(string)
+	subnetID := d.Get("subnet_id").(string)
+
+	routerLocks.Lock(routerID)
+	defer routerLocks.Unlock(routerID)
+
+	opts := edgecloudV2.RouterAttachRequest{SubnetID: subnetID}
+	if _, _, err := clientV2.Routers.Attach(ctx, routerID, &opts); err != nil {
+		return diag.Errorf("cannot attach subnet %s to router %s: %s", subnetID, routerID, err)
+	}
+
+	d.SetId(routerInterfaceID(routerID, subnetID))
+	log.Printf("[DEBUG] Finish RouterInterface creating (%s)", d.Id())
+
+	return resourceRouterInterfaceRead(ctx, d, m)
+}
+
+func resourceRouterInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start RouterInterface reading")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	subnetID := d.Get("subnet_id").(string)
+
+	router, resp, err := clientV2.Routers.Get(ctx, routerID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Router %s not found, removing interface %s from state", routerID, d.Id())
+			d.SetId("")
+
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	var found map[string]interface{}
+	for _, iface := range flattenRouterInterfaces(router) {
+		if i := iface.(map[string]interface{}); i["subnet_id"] == subnetID {
+			found = i
+			break
+		}
+	}
+	if found == nil {
+		log.Printf("[WARN] Subnet %s is not attached to router %s, removing interface from state", subnetID, routerID)
+		d.SetId("")
+
+		return diags
+	}
+
+	d.Set("region_id", router.RegionID)
+	d.Set("project_id", router.ProjectID)
+	for _, field := range []string{"port_id", "network_id", "mac_address", "ip_address"} {
+		d.Set(field, found[field])
+	}
+
+	fields := []string{"project_id", "region_id"}
+	revertState(d, &fields)
+
+	log.Println("[DEBUG] Finish RouterInterface reading")
+
+	return diags
+}
+
+func resourceRouterInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start RouterInterface deleting")
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	subnetID := d.Get("subnet_id").(string)
+
+	routerLocks.Lock(routerID)
+	defer routerLocks.Unlock(routerID)
+
+	opts := edgecloudV2.RouterDetachRequest{SubnetID: subnetID}
+	if _, resp, err := clientV2.Routers.Detach(ctx, routerID, &opts); err != nil {
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return diag.FromErr(fmt.Errorf("cannot detach subnet %s from router %s: %w", subnetID, routerID, err))
+		}
+	}
+
+	d.SetId("")
+	log.Printf("[DEBUG] Finish of RouterInterface deleting")
+
+	return diags
+}
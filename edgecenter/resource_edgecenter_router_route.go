@@ -0,0 +1,267 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	RouterRouteCreateTimeout = 1200 * time.Second
+	RouterRouteDeleteTimeout = 1200 * time.Second
+)
+
+func resourceRouterRoute() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRouterRouteCreate,
+		ReadContext:   resourceRouterRouteRead,
+		DeleteContext: resourceRouterRouteDelete,
+		Description: "Adds a static route to a router. Set 'non_exclusive_routes' on the " +
+			"'edgecenter_router' resource of the router, so it keeps the route.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(RouterRouteCreateTimeout),
+			Delete: schema.DefaultTimeout(RouterRouteDeleteTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, routerID, destination, err := ImportStringParserExtended(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				if _, errs := validation.IsCIDRNetwork(0, 32)(destination, "destination"); len(errs) > 0 {
+					return nil, errs[0]
+				}
+				d.Set("project_id", projectID)
+				d.Set("region_id", regionID)
+				d.Set("router_id", routerID)
+				d.Set("destination", destination)
+				d.SetId(routerRouteID(routerID, destination))
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
+			},
+			"project_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
+			},
+			"region_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
+			},
+			"router_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The uuid of the router.",
+			},
+			"destination": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The CIDR of the destination IPv4 subnet, with the host bits unset, e.g. '10.0.3.0/24'.",
+				ValidateFunc: validation.IsCIDRNetwork(0, 32),
+			},
+			"nexthop": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "IPv4 address to forward traffic to if its destination IP matches the destination CIDR.",
+				ValidateFunc: validation.IsIPAddress,
+			},
+		},
+	}
+}
+
+// routerRouteID returns the id of the route of the router to the destination.
+func routerRouteID(routerID, destination string) string {
+	return routerID + ":" + destination
+}
+
+// findRouterRoute returns the route of the router to the destination through the nexthop,
+// or through any nexthop when nexthop is empty. It returns nil when there is no such route.
+func findRouterRoute(router *edgecloudV2.Router, destination, nexthop string) *edgecloudV2.HostRoute {
+	for i, r := range router.Routes {
+		if r.Destination.String() == destination && (nexthop == "" || r.NextHop.String() == nexthop) {
+			return &router.Routes[i]
+		}
+	}
+
+	return nil
+}
+
+// updateRouterRoutes replaces the routes of the router, keeping its name.
+func updateRouterRoutes(ctx context.Context, clientV2 *edgecloudV2.Client, router *edgecloudV2.Router, routes []edgecloudV2.HostRoute) error {
+	opts := edgecloudV2.RouterUpdateRequest{
+		Name:   router.Name,
+		Routes: routes,
+	}
+	if _, _, err := clientV2.Routers.Update(ctx, router.ID, &opts); err != nil {
+		return fmt.Errorf("cannot update routes of router %s: %w", router.ID, err)
+	}
+
+	return nil
+}
+
+func resourceRouterRouteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start RouterRoute creating")
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("region_id", clientV2.Region)
+	d.Set("project_id", clientV2.Project)
+
+	routerID := d.Get("router_id").(string)
+	destination := d.Get("destination").(string)
+	nexthop := d.Get("nexthop").(string)
+
+	route, err := extractHostRoutesMapV2([]interface{}{map[string]interface{}{"destination": destination, "nexthop": nexthop}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routerLocks.Lock(routerID)
+	defer routerLocks.Unlock(routerID)
+
+	router, _, err := clientV2.Routers.Get(ctx, routerID)
+	if err != nil {
+		return diag.Errorf("cannot get router with ID: %s. Error: %s", routerID, err)
+	}
+	if findRouterRoute(router, destination, "") != nil {
+		return diag.Errorf("router %s already has a route to %s, import it to manage it", routerID, destination)
+	}
+
+	if err := updateRouterRoutes(ctx, clientV2, router, append(router.Routes, route...)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(routerRouteID(routerID, destination))
+	log.Printf("[DEBUG] Finish RouterRoute creating (%s)", d.Id())
+
+	return resourceRouterRouteRead(ctx, d, m)
+}
+
+func resourceRouterRouteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start RouterRoute reading")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	destination := d.Get("destination").(string)
+
+	router, resp, err := clientV2.Routers.Get(ctx, routerID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Router %s not found, removing route %s from state", routerID, d.Id())
+			d.SetId("")
+
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	// The nexthop is unknown right after import.
+	route := findRouterRoute(router, destination, d.Get("nexthop").(string))
+	if route == nil {
+		log.Printf("[WARN] Router %s has no route to %s, removing route from state", routerID, destination)
+		d.SetId("")
+
+		return diags
+	}
+
+	d.Set("region_id", router.RegionID)
+	d.Set("project_id", router.ProjectID)
+	d.Set("nexthop", route.NextHop.String())
+
+	fields := []string{"project_id", "region_id"}
+	revertState(d, &fields)
+
+	log.Println("[DEBUG] Finish RouterRoute reading")
+
+	return diags
+}
+
+func resourceRouterRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start RouterRoute deleting")
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routerID := d.Get("router_id").(string)
+
+	routerLocks.Lock(routerID)
+	defer routerLocks.Unlock(routerID)
+
+	router, resp, err := clientV2.Routers.Get(ctx, routerID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	route := findRouterRoute(router, d.Get("destination").(string), d.Get("nexthop").(string))
+	if route != nil {
+		routes := mergeRouterRoutes(router.Routes, []edgecloudV2.HostRoute{*route}, nil)
+		if err := updateRouterRoutes(ctx, clientV2, router, routes); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	log.Printf("[DEBUG] Finish of RouterRoute deleting")
+
+	return diags
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
@@ -27,8 +28,18 @@ func resourceSecret() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSecretCreate,
 		ReadContext:   resourceSecretRead,
+		UpdateContext: resourceSecretUpdate,
 		DeleteContext: resourceSecretDelete,
-		Description:   "Represent secret",
+		CustomizeDiff: customizeSecretDiff,
+		Description: `Represent secret. The API cannot change a secret, so a change of the name, the expiration date or the PEM data rotates it:
+a new secret is created, every listener of the project that uses the old secret is moved to the new one, and then the old secret is deleted.
+The listeners keep serving traffic during the rotation, and the ID of the secret changes, which 'secret_id' shows in the plan.`,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(SecretCreatingTimeout),
+			Update: schema.DefaultTimeout(SecretCreatingTimeout),
+			Delete: schema.DefaultTimeout(SecretDeletingTimeout),
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateSecretExpiration},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, secretID, err := ImportStringParser(d.Id())
@@ -37,6 +48,7 @@ func resourceSecret() *schema.Resource {
 				}
 				d.Set("project_id", projectID)
 				d.Set("region_id", regionID)
+				d.Set(SecretExpirationWarningDaysField, SecretExpirationWarningDaysDefault)
 				d.SetId(secretID)
 
 				return []*schema.ResourceData{d}, nil
@@ -45,55 +57,55 @@ func resourceSecret() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "The name of the secret.",
 			},
 			"private_key": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "SSL private key in PEM format",
 			},
 			"certificate_chain": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "SSL certificate chain of intermediates and root certificates in PEM format",
 			},
 			"certificate": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "SSL certificate in PEM format",
 			},
 			"algorithm": {
@@ -128,7 +140,6 @@ func resourceSecret() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Datetime when the secret will expire. The format is 2025-12-28T19:14:44",
 				Optional:    true,
-				ForceNew:    true,
 				StateFunc: func(val interface{}) string {
 					expTime, _ := time.Parse(RFC3339NoZ, val.(string))
 					return expTime.Format(RFC3339NoZ)
@@ -142,11 +153,44 @@ func resourceSecret() *schema.Resource {
 					return nil
 				},
 			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uuid of the secret. It changes when the secret is rotated and is planned as unknown then, so reference it instead of 'id', e.g. in the 'secret_id' of a listener.",
+			},
 			"created": {
 				Type:        schema.TypeString,
 				Description: "Datetime when the secret was created. The format is 2025-12-28T19:14:44.180394",
 				Computed:    true,
 			},
+			SecretExpirationWarningDaysField: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      SecretExpirationWarningDaysDefault,
+				Description:  "The number of days before the expiration date of the secret or of its certificate from which the plan warns about it. Set to 0 to disable the warning.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			SecretNotAfterField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Datetime when the certificate expires, in RFC 3339 format. Parsed from the certificate.",
+			},
+			SecretSubjectAlternativeNamesField: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The subject alternative names of the certificate: DNS names, IP addresses, email addresses and URIs. Parsed from the certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			SecretIssuerField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the certificate issuer. Parsed from the certificate.",
+			},
+			SecretFingerprintField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hex encoded SHA-256 fingerprint of the certificate. Parsed from the certificate.",
+			},
 		},
 	}
 }
@@ -160,25 +204,11 @@ func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	opts := &edgecloudV2.SecretCreateRequestV2{
-		Name: d.Get("name").(string),
-		Payload: edgecloudV2.Payload{
-			CertificateChain: d.Get("certificate_chain").(string),
-			Certificate:      d.Get("certificate").(string),
-			PrivateKey:       d.Get("private_key").(string),
-		},
-	}
-	if rawTime := d.Get("expiration").(string); rawTime != "" {
-		opts.Expiration = &rawTime
-	}
-
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Secrets.CreateV2, opts, clientV2, SecretCreatingTimeout)
+	secretID, err := createSecret(ctx, clientV2, d, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	secretID := taskResult.Secrets[0]
-
 	log.Printf("[DEBUG] Secret id (%s)", secretID)
 
 	d.SetId(secretID)
@@ -214,6 +244,7 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 		d.Set("expiration", expTime.Format(RFC3339NoZ))
 	}
 
+	d.Set("secret_id", secretID)
 	d.Set("name", secret.Name)
 	d.Set("algorithm", secret.Algorithm)
 	d.Set("bit_length", secret.BitLength)
@@ -224,11 +255,56 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
+	// The API does not return the certificate, so the metadata is parsed from the one in the state.
+	if certificate := d.Get("certificate").(string); certificate != "" {
+		if err := setSecretCertificateMetadata(d, certificate); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	log.Println("[DEBUG] Finish secret reading")
 
 	return diags
 }
 
+func resourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start secret updating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(secretRotationFields...) {
+		oldID := d.Id()
+		timeout := d.Timeout(schema.TimeoutUpdate)
+
+		newID, err := createSecret(ctx, clientV2, d, timeout)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		log.Printf("[DEBUG] Rotating secret %s to %s", oldID, newID)
+
+		moved, err := repointListeners(ctx, clientV2, oldID, newID)
+		if err != nil {
+			rollbackSecretRotation(ctx, clientV2, oldID, newID, moved, timeout)
+			d.Partial(true)
+			return diag.Errorf("cannot rotate secret %s: %s", oldID, err)
+		}
+
+		d.SetId(newID)
+
+		if err := deleteSecret(ctx, clientV2, oldID, timeout); err != nil {
+			return diag.Errorf("secret is rotated to %s, but the old secret %s cannot be deleted: %s", newID, oldID, err)
+		}
+	}
+
+	log.Println("[DEBUG] Finish secret updating")
+
+	return resourceSecretRead(ctx, d, m)
+}
+
 func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("[DEBUG] Start secret deleting")
 	var diags diag.Diagnostics
@@ -241,25 +317,46 @@ func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	secretID := d.Id()
 	log.Printf("[DEBUG] Secret id = %s", secretID)
 
-	results, resp, err := clientV2.Secrets.Delete(ctx, secretID)
-	if err != nil {
-		if resp.StatusCode == http.StatusNotFound {
-			d.SetId("")
-			log.Printf("[DEBUG] Finish of Secret deleting")
-			return diags
-		}
+	if err := deleteSecret(ctx, clientV2, secretID, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.FromErr(err)
 	}
 
-	taskID := results.Tasks[0]
+	d.SetId("")
+	log.Printf("[DEBUG] Finish of secret deleting")
+
+	return diags
+}
+
+func createSecret(ctx context.Context, clientV2 *edgecloudV2.Client, d *schema.ResourceData, timeout time.Duration) (string, error) {
+	opts := &edgecloudV2.SecretCreateRequestV2{
+		Name: d.Get("name").(string),
+		Payload: edgecloudV2.Payload{
+			CertificateChain: d.Get("certificate_chain").(string),
+			Certificate:      d.Get("certificate").(string),
+			PrivateKey:       d.Get("private_key").(string),
+		},
+	}
+	if rawTime := d.Get("expiration").(string); rawTime != "" {
+		opts.Expiration = &rawTime
+	}
 
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, SecretDeletingTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Secrets.CreateV2, opts, clientV2, timeout)
 	if err != nil {
-		return diag.FromErr(err)
+		return "", err
 	}
 
-	d.SetId("")
-	log.Printf("[DEBUG] Finish of secret deleting")
+	return taskResult.Secrets[0], nil
+}
 
-	return diags
+// deleteSecret deletes the secret and waits for the deletion. A secret that doesn't exist is deleted already.
+func deleteSecret(ctx context.Context, clientV2 *edgecloudV2.Client, secretID string, timeout time.Duration) error {
+	results, resp, err := clientV2.Secrets.Delete(ctx, secretID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+
+	return utilV2.WaitForTaskComplete(ctx, clientV2, results.Tasks[0], timeout)
 }
@@ -9,7 +9,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,6 +18,10 @@ import (
 
 const (
 	SecurityGroupPoint = "securitygroups"
+
+	SecurityGroupCreateTimeout = 1200 * time.Second
+	SecurityGroupUpdateTimeout = 1200 * time.Second
+	SecurityGroupDeleteTimeout = 1200 * time.Second
 )
 
 var ErrCannotDeleteSGRule = errors.New("error when deleting security group rule")
@@ -29,7 +32,13 @@ func resourceSecurityGroup() *schema.Resource {
 		ReadContext:   resourceSecurityGroupRead,
 		UpdateContext: resourceSecurityGroupUpdate,
 		DeleteContext: resourceSecurityGroupDelete,
+		CustomizeDiff: cloudMetadata.customizeDiff,
 		Description:   "Represent SecurityGroups(Firewall)",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(SecurityGroupCreateTimeout),
+			Update: schema.DefaultTimeout(SecurityGroupUpdateTimeout),
+			Delete: schema.DefaultTimeout(SecurityGroupDeleteTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, sgID, err := ImportStringParser(d.Id())
@@ -46,32 +55,32 @@ func resourceSecurityGroup() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -91,6 +100,7 @@ func resourceSecurityGroup() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			MetadataAllField: metadataAllSchema(),
 			"metadata_read_only": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -112,10 +122,15 @@ func resourceSecurityGroup() *schema.Resource {
 					},
 				},
 			},
+			"non_exclusive_rules": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to true to manage only the rules listed in 'security_group_rules' and keep the other rules of the group, e.g. the ones added by 'edgecenter_securitygroup_rule'.",
+			},
 			"security_group_rules": {
 				Type:        schema.TypeSet,
-				Required:    true,
-				Description: "Firewall rules control what inbound(ingress) and outbound(egress) traffic is allowed to enter or leave a Instance. At least one 'egress' rule should be set",
+				Optional:    true,
+				Description: "Firewall rules control what inbound(ingress) and outbound(egress) traffic is allowed to enter or leave a Instance. At least one 'egress' rule should be set, unless 'non_exclusive_rules' is set",
 				Set:         secGroupUniqueID,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -124,30 +139,16 @@ func resourceSecurityGroup() *schema.Resource {
 							Computed: true,
 						},
 						"direction": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: fmt.Sprintf("Available value is '%s', '%s'", edgecloudV2.SGRuleDirectionIngress, edgecloudV2.SGRuleDirectionEgress),
-							ValidateDiagFunc: func(v interface{}, path cty.Path) diag.Diagnostics {
-								val := v.(string)
-								switch edgecloudV2.SecurityGroupRuleDirection(val) {
-								case edgecloudV2.SGRuleDirectionIngress, edgecloudV2.SGRuleDirectionEgress:
-									return nil
-								}
-								return diag.Errorf("wrong direction '%s', available value is '%s', '%s'", val, edgecloudV2.SGRuleDirectionIngress, edgecloudV2.SGRuleDirectionEgress)
-							},
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      fmt.Sprintf("Available value is '%s', '%s'", edgecloudV2.SGRuleDirectionIngress, edgecloudV2.SGRuleDirectionEgress),
+							ValidateDiagFunc: validateSecurityGroupRuleDirection,
 						},
 						"ethertype": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: fmt.Sprintf("Available value is '%s', '%s'", edgecloudV2.EtherTypeIPv4, edgecloudV2.EtherTypeIPv6),
-							ValidateDiagFunc: func(v interface{}, path cty.Path) diag.Diagnostics {
-								val := v.(string)
-								switch edgecloudV2.EtherType(val) {
-								case edgecloudV2.EtherTypeIPv4, edgecloudV2.EtherTypeIPv6:
-									return nil
-								}
-								return diag.Errorf("wrong ethertype '%s', available value is '%s', '%s'", val, edgecloudV2.EtherTypeIPv4, edgecloudV2.EtherTypeIPv6)
-							},
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      fmt.Sprintf("Available value is '%s', '%s'", edgecloudV2.EtherTypeIPv4, edgecloudV2.EtherTypeIPv6),
+							ValidateDiagFunc: validateSecurityGroupRuleEtherType,
 						},
 						"protocol": {
 							Type:        schema.TypeString,
@@ -200,17 +201,11 @@ func resourceSecurityGroup() *schema.Resource {
 func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("[DEBUG] Start SecurityGroup creating")
 
-	var valid bool
-	vals := d.Get("security_group_rules").(*schema.Set).List()
-	for _, val := range vals {
-		rule := val.(map[string]interface{})
-		if edgecloudV2.SecurityGroupRuleDirection(rule["direction"].(string)) == edgecloudV2.SGRuleDirectionEgress {
-			valid = true
-			break
-		}
-	}
-	if !valid {
-		return diag.Errorf("at least one 'egress' rule should be set")
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := validateSecurityGroupEgressRule(d); err != nil {
+		return diag.FromErr(err)
 	}
 
 	var diags diag.Diagnostics
@@ -256,12 +251,8 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	createSecurityGroupOpts.Name = d.Get("name").(string)
 	createSecurityGroupOpts.SecurityGroupRules = rules
 
-	if metadataRaw, ok := d.GetOk("metadata_map"); ok {
-		metadataMap, err := MapInterfaceToMapString(metadataRaw)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		createSecurityGroupOpts.Metadata = *metadataMap
+	if metadata := cloudMetadata.merged(d, m); len(metadata) > 0 {
+		createSecurityGroupOpts.Metadata = metadata
 	}
 
 	opts := edgecloudV2.SecurityGroupCreateRequest{
@@ -318,7 +309,7 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 		}
 	}
 
-	if err := d.Set("metadata_map", metadataMap); err != nil {
+	if err := cloudMetadata.setFromAPI(d, m, metadataMap); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("metadata_read_only", metadataReadOnly); err != nil {
@@ -328,56 +319,11 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 	newSgRules := make([]interface{}, len(sg.SecurityGroupRules))
 	for i, sgr := range sg.SecurityGroupRules {
 		log.Printf("rules: %+v", sgr)
-		r := make(map[string]interface{})
-		r["id"] = sgr.ID
-		r["direction"] = sgr.Direction.String()
-
-		if sgr.EtherType != nil {
-			r["ethertype"] = sgr.EtherType.String()
-		}
-
-		r["protocol"] = edgecloudV2.SGRuleProtocolANY.String()
-		if sgr.Protocol != nil {
-			r["protocol"] = sgr.Protocol.String()
-		}
-
-		// Some client can create rules with protocol TCP and UDP in all ports range(1-65535)
-		// In this case API return nil for PortRangeMax and PortRangeMin,
-		// and we need to set it manually.
-		sgProtocol := edgecloudV2.SecurityGroupRuleProtocol(r["protocol"].(string))
-
-		r["port_range_max"] = 0
-		if _, ok := networkProtocolWithPort[sgProtocol]; ok {
-			r["port_range_max"] = 65535
-		}
-
-		if sgr.PortRangeMax != nil {
-			r["port_range_max"] = *sgr.PortRangeMax
-		}
-
-		r["port_range_min"] = 0
-		if _, ok := networkProtocolWithPort[sgProtocol]; ok {
-			r["port_range_min"] = 1
-		}
-
-		if sgr.PortRangeMin != nil {
-			r["port_range_min"] = *sgr.PortRangeMin
-		}
-
-		r["description"] = ""
-		if sgr.Description != nil {
-			r["description"] = *sgr.Description
-		}
-
-		r["remote_ip_prefix"] = ""
-		if sgr.RemoteIPPrefix != nil {
-			r["remote_ip_prefix"] = *sgr.RemoteIPPrefix
-		}
-
-		r["updated_at"] = sgr.UpdatedAt
-		r["created_at"] = sgr.CreatedAt
+		newSgRules[i] = flattenSecurityGroupRule(sgr)
+	}
 
-		newSgRules[i] = r
+	if d.Get("non_exclusive_rules").(bool) {
+		newSgRules = managedSecurityGroupRules(d.Get("security_group_rules").(*schema.Set), newSgRules)
 	}
 
 	if err := d.Set("security_group_rules", schema.NewSet(secGroupUniqueID, newSgRules)); err != nil {
@@ -391,17 +337,12 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 
 func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("[DEBUG] Start SecurityGroup updating")
-	var valid bool
-	vals := d.Get("security_group_rules").(*schema.Set).List()
-	for _, val := range vals {
-		rule := val.(map[string]interface{})
-		if edgecloudV2.SecurityGroupRuleDirection(rule["direction"].(string)) == edgecloudV2.SGRuleDirectionEgress {
-			valid = true
-			break
-		}
-	}
-	if !valid {
-		return diag.Errorf("at least one 'egress' rule should be set")
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if err := validateSecurityGroupEgressRule(d); err != nil {
+		return diag.FromErr(err)
 	}
 
 	clientV2, err := InitCloudClient(ctx, d, m, nil)
@@ -477,14 +418,8 @@ func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	if d.HasChange("metadata_map") {
-		_, nmd := d.GetChange("metadata_map")
-
-		nmdMapString, err := MapInterfaceToMapString(nmd)
-		if err != nil {
-			return diag.Errorf("cannot update metadata. Error: %s", err)
-		}
-		metaData := edgecloudV2.Metadata(*nmdMapString)
+	if cloudMetadata.hasChange(d) {
+		metaData := edgecloudV2.Metadata(cloudMetadata.merged(d, m))
 
 		_, err = clientV2.SecurityGroups.MetadataUpdate(ctx, gid, &metaData)
 		if err != nil {
@@ -500,6 +435,10 @@ func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 
 func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("[DEBUG] Start SecurityGroup deleting")
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
 	var diags diag.Diagnostics
 
 	clientV2, err := InitCloudClient(ctx, d, m, nil)
@@ -518,3 +457,19 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// validateSecurityGroupEgressRule checks that a group managing its rules exclusively has an egress rule.
+func validateSecurityGroupEgressRule(d *schema.ResourceData) error {
+	if d.Get("non_exclusive_rules").(bool) {
+		return nil
+	}
+
+	for _, val := range d.Get("security_group_rules").(*schema.Set).List() {
+		rule := val.(map[string]interface{})
+		if edgecloudV2.SecurityGroupRuleDirection(rule["direction"].(string)) == edgecloudV2.SGRuleDirectionEgress {
+			return nil
+		}
+	}
+
+	return errors.New("at least one 'egress' rule should be set")
+}
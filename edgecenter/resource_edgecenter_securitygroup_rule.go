@@ -0,0 +1,315 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	SecurityGroupRuleCreateTimeout = 1200 * time.Second
+	SecurityGroupRuleUpdateTimeout = 1200 * time.Second
+	SecurityGroupRuleDeleteTimeout = 1200 * time.Second
+)
+
+func resourceSecurityGroupRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSecurityGroupRuleCreate,
+		ReadContext:   resourceSecurityGroupRuleRead,
+		UpdateContext: resourceSecurityGroupRuleUpdate,
+		DeleteContext: resourceSecurityGroupRuleDelete,
+		Description: "Represent a single rule of a security group. Set 'non_exclusive_rules' on the " +
+			"'edgecenter_securitygroup' resource that manages the group, so it keeps the rule.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(SecurityGroupRuleCreateTimeout),
+			Update: schema.DefaultTimeout(SecurityGroupRuleUpdateTimeout),
+			Delete: schema.DefaultTimeout(SecurityGroupRuleDeleteTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, ruleID, sgID, err := ImportStringParserExtended(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectID)
+				d.Set("region_id", regionID)
+				d.Set("security_group_id", sgID)
+				d.SetId(ruleID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
+			},
+			"project_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
+			},
+			"region_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
+			},
+			"security_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The uuid of the security group the rule belongs to.",
+			},
+			"direction": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      fmt.Sprintf("Available value is '%s', '%s'", edgecloudV2.SGRuleDirectionIngress, edgecloudV2.SGRuleDirectionEgress),
+				ValidateDiagFunc: validateSecurityGroupRuleDirection,
+			},
+			"ethertype": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      fmt.Sprintf("Available value is '%s', '%s'", edgecloudV2.EtherTypeIPv4, edgecloudV2.EtherTypeIPv6),
+				ValidateDiagFunc: validateSecurityGroupRuleEtherType,
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("Available value is %s", strings.Join(edgecloudV2.SecurityGroupRuleProtocol("").StringList(), ",")),
+			},
+			"port_range_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  fmt.Sprintf("Must be set for network protocol: %s, %s, %s, %s, %s", edgecloudV2.SGRuleProtocolTCP, edgecloudV2.SGRuleProtocolUDP, edgecloudV2.SGRuleProtocolUDPLITE, edgecloudV2.SGRuleProtocolSCTP, edgecloudV2.SGRuleProtocolDCCP),
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"port_range_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  fmt.Sprintf("Must be set for network protocol: %s, %s, %s, %s, %s", edgecloudV2.SGRuleProtocolTCP, edgecloudV2.SGRuleProtocolUDP, edgecloudV2.SGRuleProtocolUDPLITE, edgecloudV2.SGRuleProtocolSCTP, edgecloudV2.SGRuleProtocolDCCP),
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A detailed description of the security group rule.",
+			},
+			"remote_ip_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				Description:   "The CIDR of the traffic source (ingress) or destination (egress).",
+				ConflictsWith: []string{"remote_group_id"},
+			},
+			"remote_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The uuid of the security group whose members are the traffic source (ingress) or destination (egress).",
+				ConflictsWith: []string{"remote_ip_prefix"},
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// securityGroupRuleFromResource returns the rule of the resource in the form of the security_group_rules items.
+func securityGroupRuleFromResource(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"direction":        d.Get("direction").(string),
+		"ethertype":        d.Get("ethertype").(string),
+		"protocol":         d.Get("protocol").(string),
+		"port_range_min":   d.Get("port_range_min").(int),
+		"port_range_max":   d.Get("port_range_max").(int),
+		"description":      d.Get("description").(string),
+		"remote_ip_prefix": d.Get("remote_ip_prefix").(string),
+		"remote_group_id":  d.Get("remote_group_id").(string),
+	}
+}
+
+func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start SecurityGroupRule creating")
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("region_id", clientV2.Region)
+	d.Set("project_id", clientV2.Project)
+
+	sgID := d.Get("security_group_id").(string)
+	opts, err := extractSecurityGroupRuleCreateRequestV2(securityGroupRuleFromResource(d), sgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, _, err := clientV2.SecurityGroups.RuleCreate(ctx, sgID, &opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(rule.ID)
+	log.Printf("[DEBUG] Finish SecurityGroupRule creating (%s)", rule.ID)
+
+	return resourceSecurityGroupRuleRead(ctx, d, m)
+}
+
+func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start SecurityGroupRule reading")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sg, resp, err := clientV2.SecurityGroups.Get(ctx, d.Get("security_group_id").(string))
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing SecurityGroupRule %s because its security group doesn't exist anymore", d.Id())
+			d.SetId("")
+
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	var found *edgecloudV2.SecurityGroupRule
+	for i := range sg.SecurityGroupRules {
+		if sg.SecurityGroupRules[i].ID == d.Id() {
+			found = &sg.SecurityGroupRules[i]
+			break
+		}
+	}
+	if found == nil {
+		log.Printf("[WARN] SecurityGroupRule %s not found in security group %s, removing from state", d.Id(), sg.ID)
+		d.SetId("")
+
+		return diags
+	}
+
+	d.Set("region_id", sg.RegionID)
+	d.Set("project_id", sg.ProjectID)
+
+	rule := flattenSecurityGroupRule(*found)
+	for _, field := range []string{"direction", "ethertype", "protocol", "port_range_min", "port_range_max", "description", "remote_ip_prefix", "updated_at", "created_at"} {
+		if err := d.Set(field, rule[field]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	remoteGroupID := ""
+	if found.RemoteGroupID != nil {
+		remoteGroupID = *found.RemoteGroupID
+	}
+	d.Set("remote_group_id", remoteGroupID)
+
+	log.Println("[DEBUG] Finish SecurityGroupRule reading")
+
+	return diags
+}
+
+func resourceSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start SecurityGroupRule updating")
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule := securityGroupRuleFromResource(d)
+	rule["id"] = d.Id()
+	opts, err := extractSecurityGroupRuleUpdateRequestV2(rule, d.Get("security_group_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updated, _, err := clientV2.SecurityGroups.RuleUpdate(ctx, d.Id(), &opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API may replace the rule on update, the new rule gets a new id.
+	if updated != nil && updated.ID != "" {
+		d.SetId(updated.ID)
+	}
+
+	log.Println("[DEBUG] Finish SecurityGroupRule updating")
+
+	return resourceSecurityGroupRuleRead(ctx, d, m)
+}
+
+func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start SecurityGroupRule deleting")
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleID := d.Id()
+	_, resp, err := clientV2.SecurityGroups.RuleDelete(ctx, ruleID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	if resp != nil && resp.StatusCode != http.StatusNoContent {
+		return diag.FromErr(fmt.Errorf("sgRuleId: %s, error: %w", ruleID, ErrCannotDeleteSGRule))
+	}
+
+	d.SetId("")
+	log.Printf("[DEBUG] Finish of SecurityGroupRule deleting")
+
+	return diags
+}
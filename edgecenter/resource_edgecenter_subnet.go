@@ -34,7 +34,12 @@ func resourceSubnet() *schema.Resource {
 		ReadContext:   resourceSubnetRead,
 		UpdateContext: resourceSubnetUpdate,
 		DeleteContext: resourceSubnetDelete,
+		CustomizeDiff: cloudMetadata.customizeDiff,
 		Description:   "Represent subnets. Subnetwork is a range of IP addresses in a cloud network. Addresses from this range will be assigned to machines in the cloud.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(SubnetCreatingTimeout),
+			Delete: schema.DefaultTimeout(SubnetDeleteTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, subnetID, err := ImportStringParser(d.Id())
@@ -51,32 +56,32 @@ func resourceSubnet() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			ProjectIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectNameField},
 			},
 			ProjectNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectIDField},
 			},
 			RegionIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionNameField},
 			},
 			RegionNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionIDField},
 			},
 			NameField: {
 				Type:        schema.TypeString,
@@ -156,6 +161,7 @@ func resourceSubnet() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			MetadataAllField: metadataAllSchema(),
 			MetadataReadOnlyField: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -244,17 +250,13 @@ func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		createOpts.GatewayIP = &gw
 	}
 
-	if metadataRaw, ok := d.GetOk(MetadataMapField); ok {
-		meta, err := MapInterfaceToMapString(metadataRaw)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		createOpts.Metadata = *meta
+	if metadata := cloudMetadata.merged(d, m); len(metadata) > 0 {
+		createOpts.Metadata = metadata
 	}
 
 	log.Printf("Create subnet ops: %+v", createOpts)
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Subnetworks.Create, createOpts, clientV2, SubnetCreatingTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Subnetworks.Create, createOpts, clientV2, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -326,7 +328,7 @@ func resourceSubnetRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	metadataMap, metadataReadOnly := PrepareMetadata(subnet.Metadata)
 
-	if err = d.Set(MetadataMapField, metadataMap); err != nil {
+	if err = cloudMetadata.setFromAPI(d, m, metadataMap); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -403,14 +405,8 @@ func resourceSubnetUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	if d.HasChange(MetadataMapField) {
-		_, nmd := d.GetChange(MetadataMapField)
-		meta, err := MapInterfaceToMapString(nmd)
-		if err != nil {
-			return diag.Errorf("metadata wrong fmt. Error: %s", err)
-		}
-
-		metaSubnet := edgecloudV2.Metadata(*meta)
+	if cloudMetadata.hasChange(d) {
+		metaSubnet := edgecloudV2.Metadata(cloudMetadata.merged(d, m))
 
 		_, err = clientV2.Subnetworks.MetadataUpdate(ctx, subnetID, &metaSubnet)
 		if err != nil {
@@ -436,7 +432,7 @@ func resourceSubnetDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	subnetID := d.Id()
 	log.Printf("[DEBUG] Subnet id = %s", subnetID)
 
-	err = deleteSubnetWithRetry(ctx, clientV2, subnetID)
+	err = deleteSubnetWithRetry(ctx, clientV2, subnetID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -447,8 +443,8 @@ func resourceSubnetDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	return diags
 }
 
-func deleteSubnetWithRetry(ctx context.Context, clientV2 *edgecloudV2.Client, subnetID string) error {
-	ctx, cancel := context.WithTimeout(ctx, SubnetDeleteTimeout)
+func deleteSubnetWithRetry(ctx context.Context, clientV2 *edgecloudV2.Client, subnetID string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	ticker := time.NewTicker(10 * time.Second)
@@ -457,7 +453,7 @@ func deleteSubnetWithRetry(ctx context.Context, clientV2 *edgecloudV2.Client, su
 	var lastErr error
 
 	for {
-		err := deleteSubnetOnce(ctx, clientV2, subnetID)
+		err := deleteSubnetOnce(ctx, clientV2, subnetID, timeout)
 		if err == nil {
 			return nil
 		}
@@ -477,7 +473,7 @@ func deleteSubnetWithRetry(ctx context.Context, clientV2 *edgecloudV2.Client, su
 	}
 }
 
-func deleteSubnetOnce(ctx context.Context, clientV2 *edgecloudV2.Client, subnetID string) error {
+func deleteSubnetOnce(ctx context.Context, clientV2 *edgecloudV2.Client, subnetID string, timeout time.Duration) error {
 	results, resp, err := clientV2.Subnetworks.Delete(ctx, subnetID)
 	if err != nil {
 		switch {
@@ -494,7 +490,7 @@ func deleteSubnetOnce(ctx context.Context, clientV2 *edgecloudV2.Client, subnetI
 		return ensureSubnetDeleted(ctx, clientV2, subnetID)
 	}
 
-	_, err = utilV2.WaitAndGetTaskInfo(ctx, clientV2, results.Tasks[0], SubnetDeleteTimeout)
+	_, err = utilV2.WaitAndGetTaskInfo(ctx, clientV2, results.Tasks[0], timeout)
 	if err != nil {
 		checkErr := ensureSubnetDeleted(ctx, clientV2, subnetID)
 		switch {
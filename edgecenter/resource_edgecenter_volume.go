@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -27,11 +28,17 @@ func resourceVolume() *schema.Resource {
 		ReadContext:   resourceVolumeRead,
 		UpdateContext: resourceVolumeUpdate,
 		DeleteContext: resourceVolumeDelete,
+		CustomizeDiff: customdiff.All(cloudMetadata.customizeDiff, volumeQuotaDiff),
 		Description: `A volume is a detachable block storage device akin to a USB hard drive or SSD, but located remotely in the cloud.
 Volumes can be attached to a virtual machine and manipulated like a physical hard drive.
 
 The disc type "infra_ssd" is an internal type of EdgeCenter. 	
 	`,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(VolumeCreatingTimeout),
+			Update: schema.DefaultTimeout(volumeExtendingTimeout),
+			Delete: schema.DefaultTimeout(volumeDeletingTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, volumeID, err := ImportStringParser(d.Id())
@@ -63,28 +70,32 @@ The disc type "infra_ssd" is an internal type of EdgeCenter.
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_name"},
 			},
 			"project_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{"project_id", "project_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"project_id"},
 			},
 			"region_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{"region_id"},
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -135,6 +146,7 @@ The disc type "infra_ssd" is an internal type of EdgeCenter.
 					Type: schema.TypeString,
 				},
 			},
+			MetadataAllField: metadataAllSchema(),
 			"metadata_read_only": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -173,8 +185,11 @@ func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if metadata := cloudMetadata.merged(d, m); len(metadata) > 0 {
+		opts.Metadata = metadata
+	}
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Volumes.Create, opts, clientV2, VolumeCreatingTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Volumes.Create, opts, clientV2, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.Errorf("error creating volume: %s", err)
 	}
@@ -217,7 +232,7 @@ func resourceVolumeRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	metadataMap, metadataReadOnly := PrepareMetadata(volume.MetadataDetailed)
 
-	if err = d.Set("metadata_map", metadataMap); err != nil {
+	if err = cloudMetadata.setFromAPI(d, m, metadataMap); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -262,7 +277,7 @@ func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			return diag.FromErr(err)
 		}
 
-		if err = utilV2.WaitForTaskComplete(ctx, clientV2, task.Tasks[0], volumeExtendingTimeout); err != nil {
+		if err = utilV2.WaitForTaskComplete(ctx, clientV2, task.Tasks[0], d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -287,14 +302,8 @@ func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 	}
 
-	if d.HasChange("metadata_map") {
-		_, nmd := d.GetChange("metadata_map")
-
-		metadata, err := MapInterfaceToMapString(nmd.(map[string]interface{}))
-		if err != nil {
-			return diag.Errorf("cannot get metadata. Error: %s", err)
-		}
-		metadataUpdate := edgecloudV2.Metadata(*metadata)
+	if cloudMetadata.hasChange(d) {
+		metadataUpdate := edgecloudV2.Metadata(cloudMetadata.merged(d, m))
 
 		if _, err := clientV2.Volumes.MetadataUpdate(ctx, d.Id(), &metadataUpdate); err != nil {
 			return diag.Errorf("cannot update metadata. Error: %s", err)
@@ -331,7 +340,7 @@ func resourceVolumeDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	log.Printf("[INFO] Deleting volume: %s", d.Id())
-	if err = utilV2.DeleteResourceIfExist(ctx, clientV2, clientV2.Volumes, d.Id(), volumeDeletingTimeout); err != nil {
+	if err = utilV2.DeleteResourceIfExist(ctx, clientV2, clientV2.Volumes, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("Error deleting volume: %s", err)
 	}
 	d.SetId("")
@@ -400,13 +409,21 @@ func getVolumeDataV2(ctx context.Context, d *schema.ResourceData, clientV2 *edge
 		}
 	}
 
-	if metadataRaw, ok := d.GetOk("metadata_map"); ok {
-		meta, err := MapInterfaceToMapString(metadataRaw)
-		if err != nil {
-			return nil, fmt.Errorf("volume metadata error: %w", err)
-		}
-		volumeData.Metadata = *meta
+	return &volumeData, nil
+}
+
+// volumeQuotaDiff checks the quota demand of a new volume or of the extension of a volume.
+func volumeQuotaDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && !d.HasChange("size") {
+		return nil
 	}
 
-	return &volumeData, nil
+	return CheckQuotaDemand(ctx, d, m, func(context.Context, *edgecloudV2.Client) (QuotaDemand, error) {
+		oldSize, newSize := d.GetChange("size")
+		if d.Id() != "" {
+			return QuotaDemand{QuotaVolumeSize: newSize.(int) - oldSize.(int)}, nil
+		}
+
+		return QuotaDemand{QuotaVolumeCount: 1, QuotaVolumeSize: newSize.(int)}, nil
+	})
 }
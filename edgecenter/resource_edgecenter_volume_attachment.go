@@ -0,0 +1,267 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	VolumeAttachmentCreateTimeout = 1200 * time.Second
+	VolumeAttachmentDeleteTimeout = 1200 * time.Second
+
+	volumeAttachmentStateAttached = "attached"
+	volumeAttachmentStateDetached = "detached"
+)
+
+func resourceVolumeAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVolumeAttachmentCreate,
+		ReadContext:   resourceVolumeAttachmentRead,
+		DeleteContext: resourceVolumeAttachmentDelete,
+		Description: "Attaches an existing volume to an instance. Set 'non_exclusive_data_volumes' on the " +
+			"'edgecenter_instanceV2' resource of the instance, so it keeps the volume attached.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(VolumeAttachmentCreateTimeout),
+			Delete: schema.DefaultTimeout(VolumeAttachmentDeleteTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, instanceID, volumeID, err := ImportStringParserExtended(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set(ProjectIDField, projectID)
+				d.Set(RegionIDField, regionID)
+				d.Set(InstanceIDField, instanceID)
+				d.Set(VolumeIDField, volumeID)
+				d.SetId(volumeAttachmentID(instanceID, volumeID))
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			ProjectIDField: {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectNameField},
+			},
+			ProjectNameField: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{ProjectIDField},
+			},
+			RegionIDField: {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionNameField},
+			},
+			RegionNameField: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{RegionIDField},
+			},
+			InstanceIDField: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The uuid of the instance to attach the volume to.",
+			},
+			VolumeIDField: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The uuid of the volume to attach.",
+			},
+			InstanceVolumesAttachmentTagField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The block device attachment tag (exposed in the metadata).",
+			},
+			"device": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the block device of the volume in the instance.",
+			},
+		},
+	}
+}
+
+// volumeAttachmentID returns the id of the attachment of the volume to the instance.
+func volumeAttachmentID(instanceID, volumeID string) string {
+	return instanceID + ":" + volumeID
+}
+
+// findVolumeAttachment returns the attachment of the volume to the instance, or nil when the
+// volume is not attached to it.
+func findVolumeAttachment(volume *edgecloudV2.Volume, instanceID string) *edgecloudV2.Attachment {
+	for i := range volume.Attachments {
+		if volume.Attachments[i].ServerID == instanceID {
+			return &volume.Attachments[i]
+		}
+	}
+
+	return nil
+}
+
+// volumeAttachmentStateRefreshFunc reports whether the volume is attached to the instance.
+// A deleted volume is reported as detached.
+func volumeAttachmentStateRefreshFunc(ctx context.Context, client *edgecloudV2.Client, volumeID, instanceID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		volume, resp, err := client.Volumes.Get(ctx, volumeID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return &edgecloudV2.Volume{ID: volumeID}, volumeAttachmentStateDetached, nil
+			}
+			return nil, "", err
+		}
+		if findVolumeAttachment(volume, instanceID) == nil {
+			return volume, volumeAttachmentStateDetached, nil
+		}
+
+		return volume, volumeAttachmentStateAttached, nil
+	}
+}
+
+func resourceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start VolumeAttachment creating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set(RegionIDField, clientV2.Region)
+	d.Set(ProjectIDField, clientV2.Project)
+
+	instanceID := d.Get(InstanceIDField).(string)
+	volumeID := d.Get(VolumeIDField).(string)
+
+	opts := edgecloudV2.VolumeAttachRequest{
+		InstanceID:    instanceID,
+		AttachmentTag: d.Get(InstanceVolumesAttachmentTagField).(string),
+	}
+	if _, _, err := clientV2.Volumes.Attach(ctx, volumeID, &opts); err != nil {
+		return diag.Errorf("cannot attach volume %s to instance %s: %s", volumeID, instanceID, err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{volumeAttachmentStateDetached},
+		Target:     []string{volumeAttachmentStateAttached},
+		Refresh:    volumeAttachmentStateRefreshFunc(ctx, clientV2, volumeID, instanceID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for volume (%s) to become attached: %s", volumeID, err)
+	}
+
+	d.SetId(volumeAttachmentID(instanceID, volumeID))
+	log.Printf("[DEBUG] Finish VolumeAttachment creating (%s)", d.Id())
+
+	return resourceVolumeAttachmentRead(ctx, d, m)
+}
+
+func resourceVolumeAttachmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start VolumeAttachment reading")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get(InstanceIDField).(string)
+	volumeID := d.Get(VolumeIDField).(string)
+
+	volume, resp, err := clientV2.Volumes.Get(ctx, volumeID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Volume %s not found, removing attachment %s from state", volumeID, d.Id())
+			d.SetId("")
+
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	attachment := findVolumeAttachment(volume, instanceID)
+	if attachment == nil {
+		log.Printf("[WARN] Volume %s is not attached to instance %s, removing attachment from state", volumeID, instanceID)
+		d.SetId("")
+
+		return diags
+	}
+
+	d.Set(RegionIDField, volume.RegionID)
+	d.Set(ProjectIDField, volume.ProjectID)
+	d.Set("device", attachment.Device)
+
+	fields := []string{ProjectIDField, RegionIDField}
+	revertState(d, &fields)
+
+	log.Println("[DEBUG] Finish VolumeAttachment reading")
+
+	return diags
+}
+
+func resourceVolumeAttachmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start VolumeAttachment deleting")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get(InstanceIDField).(string)
+	volumeID := d.Get(VolumeIDField).(string)
+
+	opts := edgecloudV2.VolumeDetachRequest{InstanceID: instanceID}
+	if _, resp, err := clientV2.Volumes.Detach(ctx, volumeID, &opts); err != nil {
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return diag.FromErr(fmt.Errorf("cannot detach volume %s from instance %s: %w", volumeID, instanceID, err))
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{volumeAttachmentStateAttached},
+		Target:     []string{volumeAttachmentStateDetached},
+		Refresh:    volumeAttachmentStateRefreshFunc(ctx, clientV2, volumeID, instanceID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for volume (%s) to become detached: %s", volumeID, err)
+	}
+
+	d.SetId("")
+	log.Printf("[DEBUG] Finish of VolumeAttachment deleting")
+
+	return diags
+}
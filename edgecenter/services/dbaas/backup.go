@@ -40,20 +40,20 @@ func resourceDBaaSBackup() *schema.Resource {
 		Importer: &schema.ResourceImporter{StateContext: resourceDBaaSBackupImport},
 		Schema: map[string]*schema.Schema{
 			edgecenter.ProjectIDField: {
-				Type: schema.TypeInt, Optional: true, ForceNew: true,
-				ExactlyOneOf: []string{edgecenter.ProjectIDField, edgecenter.ProjectNameField},
+				Type: schema.TypeInt, Optional: true, Computed: true, ForceNew: true,
+				ConflictsWith: []string{edgecenter.ProjectNameField},
 			},
 			edgecenter.ProjectNameField: {
-				Type: schema.TypeString, Optional: true, ForceNew: true,
-				ExactlyOneOf: []string{edgecenter.ProjectIDField, edgecenter.ProjectNameField},
+				Type: schema.TypeString, Optional: true, Computed: true, ForceNew: true,
+				ConflictsWith: []string{edgecenter.ProjectIDField},
 			},
 			edgecenter.RegionIDField: {
-				Type: schema.TypeInt, Optional: true, ForceNew: true,
-				ExactlyOneOf: []string{edgecenter.RegionIDField, edgecenter.RegionNameField},
+				Type: schema.TypeInt, Optional: true, Computed: true, ForceNew: true,
+				ConflictsWith: []string{edgecenter.RegionNameField},
 			},
 			edgecenter.RegionNameField: {
-				Type: schema.TypeString, Optional: true, ForceNew: true,
-				ExactlyOneOf: []string{edgecenter.RegionIDField, edgecenter.RegionNameField},
+				Type: schema.TypeString, Optional: true, Computed: true, ForceNew: true,
+				ConflictsWith: []string{edgecenter.RegionIDField},
 			},
 			edgecenter.NameField:                     {Type: schema.TypeString, Required: true},
 			edgecenter.DescriptionField:              {Type: schema.TypeString, Optional: true},
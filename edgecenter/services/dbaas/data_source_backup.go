@@ -23,24 +23,24 @@ func dataSourceDBaaSBackup() *schema.Resource {
 		Description: "Retrieve a DBaaS backup by its ID or unique name.",
 		Schema: map[string]*schema.Schema{
 			edgecenter.ProjectIDField: {
-				Type: schema.TypeInt, Optional: true,
-				Description:  "The project ID. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{edgecenter.ProjectIDField, edgecenter.ProjectNameField},
+				Type: schema.TypeInt, Optional: true, Computed: true,
+				Description:   "The project ID. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{edgecenter.ProjectNameField},
 			},
 			edgecenter.ProjectNameField: {
-				Type: schema.TypeString, Optional: true,
-				Description:  "The project name. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{edgecenter.ProjectIDField, edgecenter.ProjectNameField},
+				Type: schema.TypeString, Optional: true, Computed: true,
+				Description:   "The project name. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{edgecenter.ProjectIDField},
 			},
 			edgecenter.RegionIDField: {
-				Type: schema.TypeInt, Optional: true,
-				Description:  "The region ID. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{edgecenter.RegionIDField, edgecenter.RegionNameField},
+				Type: schema.TypeInt, Optional: true, Computed: true,
+				Description:   "The region ID. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{edgecenter.RegionNameField},
 			},
 			edgecenter.RegionNameField: {
-				Type: schema.TypeString, Optional: true,
-				Description:  "The region name. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{edgecenter.RegionIDField, edgecenter.RegionNameField},
+				Type: schema.TypeString, Optional: true, Computed: true,
+				Description:   "The region name. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{edgecenter.RegionIDField},
 			},
 			edgecenter.IDField: {
 				Type: schema.TypeString, Optional: true,
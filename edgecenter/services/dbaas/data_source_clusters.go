@@ -21,28 +21,32 @@ func dataSourceDBaaSClusters() *schema.Resource {
 		Description: "Represent DBaaS cluster data source.",
 		Schema: map[string]*schema.Schema{
 			edgecenter.ProjectIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{edgecenter.ProjectIDField, edgecenter.ProjectNameField},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{edgecenter.ProjectNameField},
 			},
 			edgecenter.ProjectNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
-				ExactlyOneOf: []string{edgecenter.ProjectIDField, edgecenter.ProjectNameField},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{edgecenter.ProjectIDField},
 			},
 			edgecenter.RegionIDField: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{edgecenter.RegionIDField, edgecenter.RegionNameField},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{edgecenter.RegionNameField},
 			},
 			edgecenter.RegionNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{edgecenter.RegionIDField, edgecenter.RegionNameField},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+				ConflictsWith: []string{edgecenter.RegionIDField},
 			},
 			edgecenter.NameField: {
 				Type:         schema.TypeString,
@@ -52,12 +52,13 @@ func requireProjectRegionPair(t *testing.T, res *schema.Resource, forceNew bool)
 	}
 
 	for _, pair := range pairs {
-		for _, key := range pair {
+		for i, key := range pair {
 			field := res.Schema[key]
 			require.NotNilf(t, field, "attribute %q is missing", key)
 			require.Truef(t, field.Optional, "attribute %q must be optional", key)
-			require.Equalf(t, []string{pair[0], pair[1]}, field.ExactlyOneOf,
-				"attribute %q must be exactly one of the identity pair", key)
+			require.Truef(t, field.Computed, "attribute %q must accept the provider default", key)
+			require.Equalf(t, []string{pair[1-i]}, field.ConflictsWith,
+				"attribute %q must conflict with the other half of the identity pair", key)
 			require.Equalf(t, forceNew, field.ForceNew, "attribute %q force new flag", key)
 		}
 	}
@@ -361,12 +362,13 @@ func requireScopePair(t *testing.T, res *schema.Resource) {
 		{edgecenter.ProjectIDField, edgecenter.ProjectNameField},
 		{edgecenter.RegionIDField, edgecenter.RegionNameField},
 	} {
-		for _, key := range pair {
+		for i, key := range pair {
 			field := res.Schema[key]
 			require.NotNilf(t, field, "attribute %q is missing", key)
 			require.Truef(t, field.Optional, "attribute %q must be optional", key)
-			require.Equalf(t, []string{pair[0], pair[1]}, field.ExactlyOneOf,
-				"attribute %q must be exactly one of the identity pair", key)
+			require.Truef(t, field.Computed, "attribute %q must accept the provider default", key)
+			require.Equalf(t, []string{pair[1-i]}, field.ConflictsWith,
+				"attribute %q must conflict with the other half of the identity pair", key)
 		}
 	}
 }
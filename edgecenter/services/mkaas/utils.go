@@ -95,7 +95,7 @@ func ValidateUniqueTaintKeys(set *schema.Set) error {
 	return nil
 }
 
-func customMKaaSPoolDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+func customMKaaSPoolDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
 	if raw, ok := d.GetOk(edgecenter.MKaaSPoolTaintsField); ok {
 		if err := ValidateUniqueTaintKeys(raw.(*schema.Set)); err != nil {
 			return err
@@ -116,13 +116,74 @@ func customMKaaSPoolDiff(_ context.Context, d *schema.ResourceDiff, _ interface{
 		}
 	}
 
-	return nil
+	return edgecenter.CheckQuotaDemand(ctx, d, m, func(ctx context.Context, client *edgecloudV2.Client) (edgecenter.QuotaDemand, error) {
+		return mkaasPoolQuotaDemand(ctx, client, d)
+	})
+}
+
+// mkaasPoolQuotaDemand returns the quota demand of the pool nodes, the instances and their volumes,
+// on top of the nodes the pool already has. An autoscaled pool is counted at its minimum size.
+func mkaasPoolQuotaDemand(ctx context.Context, client *edgecloudV2.Client, d *schema.ResourceDiff) (edgecenter.QuotaDemand, error) {
+	demand, err := poolNodesQuotaDemand(ctx, client, d.Get(edgecenter.FlavorField).(string),
+		poolNodeCount(d), d.Get(edgecenter.MKaaSVolumeSizeField).(int))
+	if err != nil || d.Id() == "" {
+		return demand, err
+	}
+
+	oldFlavor, _ := d.GetChange(edgecenter.FlavorField)
+	oldVolumeSize, _ := d.GetChange(edgecenter.MKaaSVolumeSizeField)
+	old, err := poolNodesQuotaDemand(ctx, client, oldFlavor.(string),
+		poolNodeCount(oldPoolValues{d: d}), oldVolumeSize.(int))
+	if err != nil {
+		return nil, err
+	}
+
+	return demand.Sub(old), nil
+}
+
+func poolNodesQuotaDemand(ctx context.Context, client *edgecloudV2.Client, flavorID string, nodes, volumeSize int) (edgecenter.QuotaDemand, error) {
+	demand, err := edgecenter.FlavorQuotaDemand(ctx, client, flavorID, nodes)
+	if err != nil {
+		return nil, err
+	}
+	demand[edgecenter.QuotaVolumeCount] = nodes
+	demand[edgecenter.QuotaVolumeSize] = nodes * volumeSize
+
+	return demand, nil
+}
+
+func poolNodeCount(d scalePolicyReader) int {
+	if minNodeCount, _, autoscale := expandScalePolicy(d); autoscale {
+		return minNodeCount
+	}
+	if nc, ok := d.GetOk(edgecenter.MKaaSNodeCountField); ok {
+		return nc.(int)
+	}
+
+	return 0
 }
 
 type scalePolicyReader interface {
 	GetOk(key string) (interface{}, bool)
 }
 
+// oldPoolValues reads the values of the pool before the planned change.
+type oldPoolValues struct {
+	d *schema.ResourceDiff
+}
+
+func (o oldPoolValues) GetOk(key string) (interface{}, bool) {
+	old, _ := o.d.GetChange(key)
+	switch v := old.(type) {
+	case int:
+		return v, v != 0
+	case []interface{}:
+		return v, len(v) > 0
+	}
+
+	return old, old != nil
+}
+
 func expandScalePolicy(d scalePolicyReader) (int, int, bool) {
 	raw, ok := d.GetOk(edgecenter.MKaaSPoolScalePolicyField)
 	if !ok {
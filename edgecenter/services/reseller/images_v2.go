@@ -124,6 +124,31 @@ If the reseller, client or project has image_ids = [] or doesn't have an image_i
 	}
 }
 
+// moveResellerImagesStateToV2 rewrites the state of an edgecenter_reseller_images for
+// edgecenter_reseller_imagesV2, which manages the same images as entity of the reseller type.
+func moveResellerImagesStateToV2(source map[string]interface{}) (map[string]interface{}, error) {
+	resellerID, ok := source[edgecenter.ResellerIDField].(float64)
+	if !ok || resellerID == 0 {
+		return nil, fmt.Errorf("the %s state has no %s", ResellerImagesResource, edgecenter.ResellerIDField)
+	}
+
+	options := make([]interface{}, 0)
+	for _, opt := range edgecenter.StateBlockItems(source, edgecenter.ResellerImagesOptionsField) {
+		v2 := make(map[string]interface{})
+		edgecenter.CopyStateAttrs(v2, opt,
+			edgecenter.RegionIDField, edgecenter.ImageIDsField, edgecenter.CreatedAtField, edgecenter.UpdatedAtField)
+		v2[edgecenter.AllPublicImagesAreAvailableField] = opt[edgecenter.ImageIDsField] == nil
+		options = append(options, v2)
+	}
+
+	return map[string]interface{}{
+		edgecenter.IDField:                    strconv.Itoa(int(resellerID)),
+		edgecenter.EntityIDField:              resellerID,
+		edgecenter.EntityTypeField:            edgecloudV2.ResellerType,
+		edgecenter.ResellerImagesOptionsField: options,
+	}, nil
+}
+
 func validateResellerImagesOptions(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	rawOptionsConfig := diff.GetRawConfig().GetAttr(edgecenter.ResellerImagesOptionsField)
 	rawOptionsList := rawOptionsConfig.AsValueSlice()
@@ -1,6 +1,10 @@
 package reseller
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
 
 type Service struct{}
 
@@ -20,3 +24,10 @@ func (Service) DataSources() map[string]*schema.Resource {
 		ResellerImagesV2DataSource: dataSourceResellerImagesV2(),
 	}
 }
+
+// StateMoves lists the resources that accept the state of their deprecated v1 type in a moved block.
+func (Service) StateMoves() map[string]map[string]edgecenter.StateMoveFunc {
+	return map[string]map[string]edgecenter.StateMoveFunc{
+		ResellerImagesV2Resource: {ResellerImagesResource: moveResellerImagesStateToV2},
+	}
+}
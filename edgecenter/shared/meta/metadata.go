@@ -100,3 +100,31 @@ func MapInterfaceToMapString(mapInterface interface{}) (*map[string]string, erro
 
 	return &mapString, nil
 }
+
+// MergeMetadata overlays the metadata set on a resource on the provider-wide defaults.
+func MergeMetadata(defaults map[string]string, resource map[string]interface{}) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(resource))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range resource {
+		merged[k] = fmt.Sprintf("%v", v)
+	}
+
+	return merged
+}
+
+// WithoutDefaultMetadata drops the keys of apiMetadata that only come from the provider-wide
+// defaults: the key holds its default value and the resource does not set it itself.
+func WithoutDefaultMetadata(apiMetadata, defaults map[string]string, resource map[string]interface{}) map[string]string {
+	own := make(map[string]string, len(apiMetadata))
+	for k, v := range apiMetadata {
+		_, set := resource[k]
+		if dv, ok := defaults[k]; ok && dv == v && !set {
+			continue
+		}
+		own[k] = v
+	}
+
+	return own
+}
@@ -0,0 +1,36 @@
+package meta
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeMetadata(t *testing.T) {
+	defaults := map[string]string{"owner": "platform", "env": "prod"}
+	got := MergeMetadata(defaults, map[string]interface{}{"env": "staging", "app": "web"})
+	want := map[string]string{"owner": "platform", "env": "staging", "app": "web"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeMetadata = %v, want %v", got, want)
+	}
+	if defaults["env"] != "prod" {
+		t.Error("MergeMetadata modified the defaults")
+	}
+	if got := MergeMetadata(nil, nil); len(got) != 0 {
+		t.Errorf("MergeMetadata(nil, nil) = %v, want empty", got)
+	}
+}
+
+func TestWithoutDefaultMetadata(t *testing.T) {
+	defaults := map[string]string{"owner": "platform", "env": "prod", "cost_center": "42"}
+	api := map[string]string{"owner": "platform", "env": "prod", "cost_center": "7", "app": "web"}
+
+	got := WithoutDefaultMetadata(api, defaults, map[string]interface{}{"env": "prod", "app": "web"})
+	want := map[string]string{"env": "prod", "cost_center": "7", "app": "web"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WithoutDefaultMetadata = %v, want %v", got, want)
+	}
+
+	if got := WithoutDefaultMetadata(api, nil, nil); !reflect.DeepEqual(got, api) {
+		t.Errorf("without defaults = %v, want %v", got, api)
+	}
+}
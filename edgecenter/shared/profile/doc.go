@@ -0,0 +1,2 @@
+// Package profile reads named credential profiles from the shared EdgeCenter config file.
+package profile
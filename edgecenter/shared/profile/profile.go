@@ -0,0 +1,148 @@
+package profile
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultConfigFile is the shared config file used when the provider does not name one.
+const DefaultConfigFile = "~/.edgecenter/config.yaml"
+
+const tokenCommandTimeout = 30 * time.Second
+
+// Profile is one named entry of the shared config file. Empty fields are not set by the profile.
+type Profile struct {
+	Token        string `yaml:"token"`
+	TokenFile    string `yaml:"token_file"`
+	TokenCommand string `yaml:"token_command"`
+
+	APIEndpoint   string `yaml:"api_endpoint"`
+	CloudAPI      string `yaml:"cloud_api"`
+	CDNAPI        string `yaml:"cdn_api"`
+	RMONAPI       string `yaml:"rmon_api"`
+	StorageAPI    string `yaml:"storage_api"`
+	DNSAPI        string `yaml:"dns_api"`
+	ProtectionAPI string `yaml:"protection_api"`
+	PlatformAPI   string `yaml:"platform_api"`
+}
+
+type configFile struct {
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// Load reads the profile with the given name from the config file at path.
+// A leading ~ in path is expanded to the home directory of the user.
+func Load(path, name string) (*Profile, error) {
+	path, err := ExpandHome(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read shared config file: %w", err)
+	}
+
+	var cfg configFile
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse shared config file %s: %w", path, err)
+	}
+
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q is not defined in %s", name, path)
+	}
+
+	return &p, nil
+}
+
+// ResolveToken returns the token of the profile, taken from the first of token,
+// token_file and token_command that is set. It returns an empty string when none is.
+func (p *Profile) ResolveToken(ctx context.Context) (string, error) {
+	switch {
+	case p.Token != "":
+		return p.Token, nil
+	case p.TokenFile != "":
+		return ReadTokenFile(p.TokenFile)
+	case p.TokenCommand != "":
+		return RunTokenCommand(ctx, p.TokenCommand)
+	}
+
+	return "", nil
+}
+
+// ReadTokenFile returns the content of the file without surrounding whitespace.
+func ReadTokenFile(path string) (string, error) {
+	path, err := ExpandHome(path)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// RunTokenCommand runs command through the shell and returns its standard output without
+// surrounding whitespace. The output is never part of an error, only standard error is.
+func RunTokenCommand(ctx context.Context, command string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "", fmt.Errorf("token command timed out after %s", tokenCommandTimeout)
+		}
+		return "", fmt.Errorf("token command: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", errors.New("token command printed no token")
+	}
+
+	return token, nil
+}
+
+// ExpandHome replaces a leading ~ with the home directory of the user.
+func ExpandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("expand %s: %w", path, err)
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
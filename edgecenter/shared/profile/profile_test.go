@@ -0,0 +1,114 @@
+package profile
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeFile(t, "config.yaml", `
+profiles:
+  default:
+    token: default-token
+  staging:
+    token_file: /run/secrets/ec
+    api_endpoint: https://api.staging.example
+    cloud_api: https://cloud.staging.example
+`)
+
+	p, err := Load(path, "staging")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if p.TokenFile != "/run/secrets/ec" || p.APIEndpoint != "https://api.staging.example" || p.CloudAPI != "https://cloud.staging.example" {
+		t.Errorf("unexpected profile %+v", p)
+	}
+	if p.Token != "" {
+		t.Errorf("token leaked from another profile: %q", p.Token)
+	}
+
+	if _, err := Load(path, "prod"); err == nil || !strings.Contains(err.Error(), `"prod"`) {
+		t.Errorf("expected an unknown profile error, got %v", err)
+	}
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yaml"), "default"); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+	if _, err := Load(writeFile(t, "bad.yaml", "profiles: ["), "default"); err == nil {
+		t.Error("expected an error for invalid YAML")
+	}
+}
+
+func TestResolveToken(t *testing.T) {
+	ctx := context.Background()
+	tokenFile := writeFile(t, "token", "  file-token\n")
+
+	cases := map[string]struct {
+		profile Profile
+		want    string
+	}{
+		"none":    {Profile{}, ""},
+		"inline":  {Profile{Token: "inline", TokenFile: tokenFile}, "inline"},
+		"file":    {Profile{TokenFile: tokenFile, TokenCommand: "echo command"}, "file-token"},
+		"command": {Profile{TokenCommand: "echo ' command-token '"}, "command-token"},
+	}
+	for name, tc := range cases {
+		got, err := tc.profile.ResolveToken(ctx)
+		if err != nil {
+			t.Fatalf("%s: ResolveToken: %v", name, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s: token = %q, want %q", name, got, tc.want)
+		}
+	}
+}
+
+func TestReadTokenFileRejectsEmptyFile(t *testing.T) {
+	if _, err := ReadTokenFile(writeFile(t, "token", "\n")); err == nil {
+		t.Error("expected an error for an empty token file")
+	}
+}
+
+func TestRunTokenCommandErrorHidesStdout(t *testing.T) {
+	_, err := RunTokenCommand(context.Background(), "echo secret-token; echo boom >&2; exit 3")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("error contains the command output: %v", err)
+	}
+	if !strings.Contains(err.Error(), "boom") {
+		t.Errorf("error does not contain stderr: %v", err)
+	}
+
+	if _, err := RunTokenCommand(context.Background(), "true"); err == nil {
+		t.Error("expected an error for a command without output")
+	}
+}
+
+func TestExpandHome(t *testing.T) {
+	t.Setenv("HOME", "/home/ec")
+
+	got, err := ExpandHome("~/.edgecenter/config.yaml")
+	if err != nil {
+		t.Fatalf("ExpandHome: %v", err)
+	}
+	if got != "/home/ec/.edgecenter/config.yaml" {
+		t.Errorf("ExpandHome = %q", got)
+	}
+	if got, _ := ExpandHome("/etc/ec.yaml"); got != "/etc/ec.yaml" {
+		t.Errorf("absolute path changed to %q", got)
+	}
+}
@@ -0,0 +1,75 @@
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// BaseOptions configures the network layer shared by all API clients.
+type BaseOptions struct {
+	// CABundleFile is a PEM file with certificates trusted in addition to the system pool.
+	CABundleFile string
+	// InsecureSkipVerify disables verification of the API server certificate.
+	InsecureSkipVerify bool
+	// ProxyURL overrides the HTTP(S)_PROXY environment variables when set.
+	ProxyURL string
+	// ClientCertFile and ClientKeyFile are a PEM certificate and key presented to the server.
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+// NewBase returns the transport that sends the requests of all API clients.
+// It starts from the settings of http.DefaultTransport and applies the TLS and proxy options on top.
+func NewBase(opts BaseOptions) (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify, //nolint:gosec // explicitly requested by the user
+	}
+
+	if opts.CABundleFile != "" {
+		pem, err := os.ReadFile(opts.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA bundle %s contains no PEM certificates", opts.CABundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	switch {
+	case opts.ClientCertFile != "" && opts.ClientKeyFile != "":
+		cert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case opts.ClientCertFile != "" || opts.ClientKeyFile != "":
+		return nil, fmt.Errorf("client certificate and key must be set together")
+	}
+
+	t.TLSClientConfig = tlsConfig
+
+	if opts.ProxyURL != "" {
+		proxy, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse proxy URL: %w", err)
+		}
+		if proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("proxy URL %q must include a scheme and a host", opts.ProxyURL)
+		}
+		t.Proxy = http.ProxyURL(proxy)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,95 @@
+package transport
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeServerCA(t *testing.T, srv *httptest.Server) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write CA bundle: %v", err)
+	}
+
+	return path
+}
+
+func TestNewBaseTrustsCABundle(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer srv.Close()
+
+	plain, err := NewBase(BaseOptions{})
+	if err != nil {
+		t.Fatalf("NewBase: %v", err)
+	}
+	if _, err := (&http.Client{Transport: plain}).Get(srv.URL); err == nil {
+		t.Fatal("self-signed server accepted without the CA bundle")
+	}
+
+	withCA, err := NewBase(BaseOptions{CABundleFile: writeServerCA(t, srv)})
+	if err != nil {
+		t.Fatalf("NewBase: %v", err)
+	}
+	resp, err := (&http.Client{Transport: withCA}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("get with CA bundle: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestNewBaseInsecureSkipVerify(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer srv.Close()
+
+	tr, err := NewBase(BaseOptions{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("NewBase: %v", err)
+	}
+	resp, err := (&http.Client{Transport: tr}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestNewBaseProxy(t *testing.T) {
+	tr, err := NewBase(BaseOptions{ProxyURL: "http://proxy.corp:3128"})
+	if err != nil {
+		t.Fatalf("NewBase: %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://api.edgecenter.ru/cloud/v1/regions", nil)
+	proxy, err := tr.Proxy(req)
+	if err != nil {
+		t.Fatalf("proxy: %v", err)
+	}
+	if want, _ := url.Parse("http://proxy.corp:3128"); proxy.String() != want.String() {
+		t.Errorf("proxy = %s, want %s", proxy, want)
+	}
+}
+
+func TestNewBaseRejectsInvalidOptions(t *testing.T) {
+	empty := filepath.Join(t.TempDir(), "empty.pem")
+	if err := os.WriteFile(empty, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]BaseOptions{
+		"missing CA bundle":   {CABundleFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"CA bundle not PEM":   {CABundleFile: empty},
+		"cert without key":    {ClientCertFile: empty},
+		"unreadable key pair": {ClientCertFile: empty, ClientKeyFile: empty},
+		"proxy without host":  {ProxyURL: "proxy.corp"},
+	}
+	for name, opts := range cases {
+		if _, err := NewBase(opts); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,2 @@
+// Package transport holds the HTTP round trippers shared by the API clients of all products.
+package transport
@@ -0,0 +1,255 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Logging subsystems of the API clients. The level of each one can be raised on its
+// own, e.g. TF_LOG_PROVIDER_EDGECENTER_DNS=TRACE traces only the DNS API.
+const (
+	SubsystemCloud      = "edgecenter.cloud"
+	SubsystemCDN        = "edgecenter.cdn"
+	SubsystemDNS        = "edgecenter.dns"
+	SubsystemRMON       = "edgecenter.rmon"
+	SubsystemProtection = "edgecenter.protection"
+	SubsystemStorage    = "edgecenter.storage"
+
+	levelEnvPrefix = "TF_LOG_PROVIDER_EDGECENTER"
+	maskedValue    = "***"
+	maxLoggedBody  = 16 << 10
+)
+
+var (
+	// sensitiveHeaders are matched case-insensitively; APIKey is the scheme of the permanent token.
+	sensitiveHeaders = map[string]bool{
+		"authorization": true,
+		"apikey":        true,
+		"x-auth-token":  true,
+		"x-api-key":     true,
+		"cookie":        true,
+		"set-cookie":    true,
+	}
+
+	sensitiveQueryParams = map[string]bool{
+		"token":        true,
+		"api_key":      true,
+		"apikey":       true,
+		"access_token": true,
+	}
+
+	// sensitiveBodyField matches the string value of a secret JSON field, keeping the key.
+	sensitiveBodyField = regexp.MustCompile(
+		`("(?i:password|private_key|secret|secret_key|access_key|s3_access_key|s3_secret_key|` +
+			`s3_access_key_id|s3_secret_access_key|token|api_key|permanent_api_token)"\s*:\s*)"(?:[^"\\]|\\.)*"`,
+	)
+
+	requestIDHeaders = []string{"X-Request-Id", "Request-Id", "X-Trace-Id", "X-Correlation-Id"}
+)
+
+type loggingRoundTripper struct {
+	next      http.RoundTripper
+	subsystem string
+	levelEnv  string
+}
+
+// NewLogging wraps next with tflog tracing under the given subsystem. Method, URL, status,
+// latency and the request ID are logged at DEBUG, headers and bodies at TRACE. Credentials
+// in headers and in well-known secret fields of JSON bodies are masked. Requests whose
+// context carries no Terraform logger, such as those of the legacy cloud client, are
+// logged through the standard logger instead, which follows TF_LOG only.
+func NewLogging(next http.RoundTripper, subsystem string) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &loggingRoundTripper{
+		next:      next,
+		subsystem: subsystem,
+		levelEnv:  strings.ToUpper(strings.TrimPrefix(subsystem, "edgecenter.")),
+	}
+}
+
+func (lt *loggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), lt.subsystem, tflog.WithLevelFromEnv(levelEnvPrefix, lt.levelEnv))
+	// NewSubsystem returns the context untouched when it has no provider root logger.
+	fallback := ctx == req.Context()
+
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    RedactURL(req.URL),
+	}
+	lt.log(ctx, fallback, "DEBUG", "Sending API request", fields)
+	// Copying and masking the bodies is only worth it when the TRACE lines are written.
+	trace := traceEnabled(lt.levelEnv)
+	if trace {
+		lt.log(ctx, fallback, "TRACE", "API request details", map[string]interface{}{
+			"http_method":          req.Method,
+			"http_url":             RedactURL(req.URL),
+			"http_request_headers": MaskHeaders(req.Header),
+			"http_request_body":    MaskBody(peekRequestBody(req)),
+		})
+	}
+
+	start := time.Now()
+	resp, err := lt.next.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		lt.log(ctx, fallback, "WARN", "API request failed", fields)
+		return nil, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	if id := requestID(resp.Header, req.Header); id != "" {
+		fields["http_request_id"] = id
+	}
+	lt.log(ctx, fallback, "DEBUG", "Received API response", fields)
+	if trace {
+		lt.log(ctx, fallback, "TRACE", "API response details", map[string]interface{}{
+			"http_status":           resp.StatusCode,
+			"http_response_headers": MaskHeaders(resp.Header),
+			"http_response_body":    MaskBody(peekResponseBody(resp)),
+		})
+	}
+
+	return resp, nil
+}
+
+// traceEnabled reports whether the TRACE lines of the subsystem are written. The most specific
+// variable that is set decides: the one of the subsystem, the one of the provider, then
+// TF_LOG_PROVIDER and TF_LOG, which Terraform and the SDK filter the provider output with.
+func traceEnabled(levelEnv string) bool {
+	for _, env := range []string{levelEnvPrefix + "_" + levelEnv, levelEnvPrefix, "TF_LOG_PROVIDER", "TF_LOG"} {
+		if level := strings.ToUpper(os.Getenv(env)); level != "" {
+			return level == "TRACE" || level == "JSON"
+		}
+	}
+
+	return false
+}
+
+// log writes to the tflog subsystem, or to the standard logger with the level as a
+// bracketed prefix, which is how the SDK picks the level of such lines.
+func (lt *loggingRoundTripper) log(ctx context.Context, fallback bool, level, msg string, fields map[string]interface{}) {
+	if fallback {
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var b strings.Builder
+		for _, k := range keys {
+			fmt.Fprintf(&b, " %s=%v", k, fields[k])
+		}
+		log.Printf("[%s] %s: %s:%s", level, lt.subsystem, msg, b.String())
+		return
+	}
+
+	switch level {
+	case "TRACE":
+		tflog.SubsystemTrace(ctx, lt.subsystem, msg, fields)
+	case "WARN":
+		tflog.SubsystemWarn(ctx, lt.subsystem, msg, fields)
+	default:
+		tflog.SubsystemDebug(ctx, lt.subsystem, msg, fields)
+	}
+}
+
+// MaskHeaders returns the headers as a flat map with credentials replaced by a mask.
+func MaskHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for k, v := range h {
+		if sensitiveHeaders[strings.ToLower(k)] {
+			out[k] = maskedValue
+			continue
+		}
+		out[k] = strings.Join(v, ", ")
+	}
+
+	return out
+}
+
+// MaskBody replaces the values of secret JSON fields such as password or private_key.
+func MaskBody(body string) string {
+	return sensitiveBodyField.ReplaceAllString(body, `$1"`+maskedValue+`"`)
+}
+
+// RedactURL hides user info and credentials passed as query parameters.
+func RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	q := u.Query()
+	masked := false
+	for k := range q {
+		if sensitiveQueryParams[strings.ToLower(k)] {
+			q.Set(k, maskedValue)
+			masked = true
+		}
+	}
+	if !masked {
+		return u.Redacted()
+	}
+	c := *u
+	c.RawQuery = q.Encode()
+
+	return c.Redacted()
+}
+
+// requestID returns the first request ID found, looking at the headers in the given order.
+func requestID(headers ...http.Header) string {
+	for _, h := range headers {
+		for _, name := range requestIDHeaders {
+			if v := h.Get(name); v != "" {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
+
+// peekRequestBody reads the body through GetBody, so the request itself stays untouched.
+func peekRequestBody(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil {
+		return ""
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+	b, _ := io.ReadAll(io.LimitReader(body, maxLoggedBody))
+
+	return string(b)
+}
+
+// peekResponseBody reads the beginning of the body and puts it back in front of the rest.
+func peekResponseBody(resp *http.Response) string {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return ""
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBody))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(b), resp.Body), resp.Body}
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
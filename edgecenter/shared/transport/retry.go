@@ -0,0 +1,223 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultMaxRetries = 4
+	DefaultMaxBackoff = 30 * time.Second
+
+	minBackoff = time.Second
+)
+
+// RetryOptions configures the retrying round tripper.
+type RetryOptions struct {
+	// MaxRetries is the number of attempts after the first one. Zero disables retries.
+	MaxRetries int
+	// MaxBackoff caps both the exponential backoff and a server-provided Retry-After.
+	MaxBackoff time.Duration
+	// RequestsPerSecond limits the request rate across all clients sharing the
+	// round tripper. Zero means no limit.
+	RequestsPerSecond float64
+}
+
+// idempotentMethods lists the methods that are safe to repeat after a gateway error. The backend may
+// have processed a request whose response got lost, and repeating a POST could create a second object.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+}
+
+// retryable reports whether a response means "try again later" rather than "this request is wrong".
+// A 429 is retried for every method, since the request was rejected before it was processed.
+func retryable(method string, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return idempotentMethods[method]
+	}
+
+	return false
+}
+
+type retryRoundTripper struct {
+	next    http.RoundTripper
+	opts    RetryOptions
+	limiter *tokenBucket
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// NewRetry wraps next with rate limiting and retries of 429 responses, and of 502 and 503 responses
+// to idempotent requests.
+// A Retry-After header is honored up to MaxBackoff; without it the delay grows
+// exponentially with jitter. Requests whose body cannot be replayed are sent once.
+func NewRetry(next http.RoundTripper, opts RetryOptions) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = DefaultMaxBackoff
+	}
+
+	return &retryRoundTripper{
+		next:    next,
+		opts:    opts,
+		limiter: newTokenBucket(opts.RequestsPerSecond),
+		sleep:   sleepContext,
+	}
+}
+
+func (rt *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if err := rt.limiter.wait(ctx, rt.sleep); err != nil {
+			return nil, err
+		}
+
+		attemptReq := req
+		if attempt > 0 {
+			var err error
+			attemptReq, err = rewind(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := rt.next.RoundTrip(attemptReq)
+		if err != nil || !retryable(req.Method, resp.StatusCode) {
+			return resp, err
+		}
+		if attempt >= rt.opts.MaxRetries || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		delay := rt.backoff(attempt, resp.Header.Get("Retry-After"))
+		log.Printf("[DEBUG] %s %s returned %d, retrying in %s (attempt %d of %d)",
+			req.Method, req.URL.Redacted(), resp.StatusCode, delay, attempt+1, rt.opts.MaxRetries)
+
+		// Drain the body so the connection can be reused.
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		resp.Body.Close()
+
+		if err := rt.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (rt *retryRoundTripper) backoff(attempt int, retryAfter string) time.Duration {
+	if d, ok := parseRetryAfter(retryAfter, time.Now()); ok {
+		return min(d, rt.opts.MaxBackoff)
+	}
+
+	d := minBackoff << attempt
+	if d <= 0 || d > rt.opts.MaxBackoff {
+		d = rt.opts.MaxBackoff
+	}
+	// Full jitter in the upper half keeps parallel resources from retrying in lockstep.
+	half := d / 2
+
+	return half + rand.N(half+1)
+}
+
+// parseRetryAfter accepts both forms of the header: delay in seconds and an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+func rewind(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+
+	return clone, nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// tokenBucket is a minimal rate limiter with a burst of one second worth of requests.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+	burst := max(rate, 1)
+
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, now: time.Now}
+}
+
+// reserve takes a token and returns how long the caller has to wait for it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) wait(ctx context.Context, sleep func(context.Context, time.Duration) error) error {
+	if b == nil {
+		return nil
+	}
+
+	return sleep(ctx, b.reserve())
+}
@@ -0,0 +1,236 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// statusSequence answers with the given statuses in order and with 200 afterwards.
+func statusSequence(t *testing.T, retryAfter string, statuses ...int) (*httptest.Server, *atomic.Int32, *[]string) {
+	t.Helper()
+	var calls atomic.Int32
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if n <= len(statuses) {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &calls, &bodies
+}
+
+func newTestRetry(opts RetryOptions, slept *[]time.Duration) *retryRoundTripper {
+	rt := NewRetry(nil, opts).(*retryRoundTripper)
+	rt.sleep = func(_ context.Context, d time.Duration) error {
+		*slept = append(*slept, d)
+		return nil
+	}
+
+	return rt
+}
+
+func TestRetryRetriesThrottledResponses(t *testing.T) {
+	srv, calls, _ := statusSequence(t, "2", http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusBadGateway)
+	var slept []time.Duration
+	client := &http.Client{Transport: newTestRetry(RetryOptions{MaxRetries: 3, MaxBackoff: time.Minute}, &slept)}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+	if got := calls.Load(); got != 4 {
+		t.Errorf("calls = %d, want 4", got)
+	}
+	for i, d := range slept {
+		if d != 2*time.Second {
+			t.Errorf("delay %d = %s, want Retry-After of 2s", i, d)
+		}
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	srv, calls, _ := statusSequence(t, "", http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests)
+	var slept []time.Duration
+	client := &http.Client{Transport: newTestRetry(RetryOptions{MaxRetries: 1}, &slept)}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want 429", resp.StatusCode)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+}
+
+func TestRetryDoesNotRetryOtherErrors(t *testing.T) {
+	srv, calls, _ := statusSequence(t, "", http.StatusInternalServerError)
+	var slept []time.Duration
+	client := &http.Client{Transport: newTestRetry(RetryOptions{MaxRetries: 3}, &slept)}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestRetryReplaysRequestBody(t *testing.T) {
+	srv, _, bodies := statusSequence(t, "0", http.StatusTooManyRequests)
+	var slept []time.Duration
+	client := &http.Client{Transport: newTestRetry(RetryOptions{MaxRetries: 2}, &slept)}
+
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader(`{"name":"x"}`))
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	resp.Body.Close()
+
+	if len(*bodies) != 2 {
+		t.Fatalf("calls = %d, want 2", len(*bodies))
+	}
+	for i, b := range *bodies {
+		if b != `{"name":"x"}` {
+			t.Errorf("body of attempt %d = %q", i, b)
+		}
+	}
+}
+
+func TestRetrySkipsNonReplayableBody(t *testing.T) {
+	srv, calls, _ := statusSequence(t, "0", http.StatusTooManyRequests)
+	var slept []time.Duration
+	client := &http.Client{Transport: newTestRetry(RetryOptions{MaxRetries: 2}, &slept)}
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, io.NopCloser(strings.NewReader("payload")))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestRetryGatewayErrorsOnlyForIdempotentMethods(t *testing.T) {
+	tests := []struct {
+		method string
+		status int
+		want   int32
+	}{
+		{http.MethodPost, http.StatusBadGateway, 1},
+		{http.MethodPost, http.StatusServiceUnavailable, 1},
+		{http.MethodPatch, http.StatusServiceUnavailable, 1},
+		{http.MethodPost, http.StatusTooManyRequests, 2},
+		{http.MethodPut, http.StatusServiceUnavailable, 2},
+		{http.MethodDelete, http.StatusBadGateway, 2},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %d", tt.method, tt.status), func(t *testing.T) {
+			srv, calls, _ := statusSequence(t, "0", tt.status)
+			var slept []time.Duration
+			client := &http.Client{Transport: newTestRetry(RetryOptions{MaxRetries: 2}, &slept)}
+
+			req, _ := http.NewRequest(tt.method, srv.URL, strings.NewReader(`{"name":"x"}`))
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("%s: %v", tt.method, err)
+			}
+			resp.Body.Close()
+
+			if got := calls.Load(); got != tt.want {
+				t.Errorf("calls = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryBackoffIsCapped(t *testing.T) {
+	rt := NewRetry(nil, RetryOptions{MaxRetries: 10, MaxBackoff: 5 * time.Second}).(*retryRoundTripper)
+
+	if d := rt.backoff(0, "3600"); d != 5*time.Second {
+		t.Errorf("Retry-After backoff = %s, want capped 5s", d)
+	}
+	for attempt := 0; attempt < 10; attempt++ {
+		if d := rt.backoff(attempt, ""); d > 5*time.Second || d <= 0 {
+			t.Errorf("attempt %d backoff = %s, want within (0, 5s]", attempt, d)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		in     string
+		want   time.Duration
+		wantOK bool
+	}{
+		{in: "", wantOK: false},
+		{in: "7", want: 7 * time.Second, wantOK: true},
+		{in: "-1", wantOK: false},
+		{in: "soon", wantOK: false},
+		{in: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second, wantOK: true},
+		{in: now.Add(-time.Minute).Format(http.TimeFormat), want: 0, wantOK: true},
+	}
+	for _, c := range cases {
+		got, ok := parseRetryAfter(c.in, now)
+		if ok != c.wantOK || got != c.want {
+			t.Errorf("parseRetryAfter(%q) = (%s, %t), want (%s, %t)", c.in, got, ok, c.want, c.wantOK)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := newTokenBucket(2)
+	b.now = func() time.Time { return now }
+
+	// The burst is one second worth of requests.
+	for i := 0; i < 2; i++ {
+		if d := b.reserve(); d != 0 {
+			t.Fatalf("request %d within burst waited %s", i, d)
+		}
+	}
+	if d := b.reserve(); d != 500*time.Millisecond {
+		t.Errorf("third request waits %s, want 500ms", d)
+	}
+
+	now = now.Add(2 * time.Second)
+	if d := b.reserve(); d != 0 {
+		t.Errorf("request after refill waited %s", d)
+	}
+
+	if newTokenBucket(0) != nil {
+		t.Error("zero rate must disable the limiter")
+	}
+}
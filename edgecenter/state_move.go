@@ -0,0 +1,28 @@
+package edgecenter
+
+// StateMoveFunc rewrites the raw state of a resource of another type into the raw state of the
+// resource that accepts it in a moved block. The source state is upgraded to the current schema
+// version of its type first. Attributes the target schema does not know are dropped afterwards.
+type StateMoveFunc func(source map[string]interface{}) (map[string]interface{}, error)
+
+// CopyStateAttrs copies the given attributes of the raw state src that have a value into dst.
+func CopyStateAttrs(dst, src map[string]interface{}, keys ...string) {
+	for _, key := range keys {
+		if v, ok := src[key]; ok && v != nil {
+			dst[key] = v
+		}
+	}
+}
+
+// StateBlockItems returns the non-null items of the list or set block field of the raw state.
+func StateBlockItems(rawState map[string]interface{}, field string) []map[string]interface{} {
+	raw, _ := rawState[field].([]interface{})
+	items := make([]map[string]interface{}, 0, len(raw))
+	for _, r := range raw {
+		if item, ok := r.(map[string]interface{}); ok {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
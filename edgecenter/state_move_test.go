@@ -0,0 +1,99 @@
+package edgecenter
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func decodeRawState(t *testing.T, state string) map[string]interface{} {
+	t.Helper()
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal([]byte(state), &rawState); err != nil {
+		t.Fatalf("decode state: %v", err)
+	}
+
+	return rawState
+}
+
+func TestMoveInstanceStateToV2(t *testing.T) {
+	source := decodeRawState(t, `{
+		"id": "instance",
+		"project_id": 1,
+		"region_id": 2,
+		"flavor_id": "g1-standard-2-4",
+		"userdata": "ZWNobw==",
+		"metadata": [{"key": "env", "value": "prod"}],
+		"interface": [
+			{"type": "subnet", "order": 1, "network_id": "net", "subnet_id": "subnet", "port_id": "port-1", "ip_address": "10.0.0.2"},
+			{"type": "external", "order": 0, "network_id": "ext", "subnet_id": "ext-subnet", "port_id": "port-0"},
+			{"type": "reserved_fixed_ip", "order": 2, "network_id": "net", "port_id": "reserved"}
+		],
+		"volume": [
+			{"volume_id": "boot", "boot_index": 0, "size": 10},
+			{"id": "data", "volume_id": "", "boot_index": 1, "size": 5}
+		]
+	}`)
+
+	target, err := moveInstanceStateToV2(source)
+	if err != nil {
+		t.Fatalf("move: %v", err)
+	}
+
+	if target[InstanceUserDataField] != "ZWNobw==" {
+		t.Errorf("user_data = %v", target[InstanceUserDataField])
+	}
+	if want := map[string]interface{}{"env": "prod"}; !reflect.DeepEqual(target[MetadataField], want) {
+		t.Errorf("metadata = %v, want %v", target[MetadataField], want)
+	}
+
+	wantInterfaces := []interface{}{
+		map[string]interface{}{
+			TypeField: "external", IsDefaultField: true, NetworkIDField: "", SubnetIDField: "",
+			InstanceReservedFixedIPPortIDField: "", PortIDField: "port-0",
+		},
+		map[string]interface{}{
+			TypeField: "subnet", IsDefaultField: false, NetworkIDField: "net", SubnetIDField: "subnet",
+			InstanceReservedFixedIPPortIDField: "", PortIDField: "port-1", IPAddressField: "10.0.0.2",
+		},
+		map[string]interface{}{
+			TypeField: "reserved_fixed_ip", IsDefaultField: false, NetworkIDField: "", SubnetIDField: "",
+			InstanceReservedFixedIPPortIDField: "reserved", PortIDField: "reserved",
+		},
+	}
+	if !reflect.DeepEqual(target[InstanceInterfacesField], wantInterfaces) {
+		t.Errorf("interfaces = %#v, want %#v", target[InstanceInterfacesField], wantInterfaces)
+	}
+
+	wantBoot := []interface{}{map[string]interface{}{
+		InstanceVolumeIDField: "boot", InstanceBootVolumesBootIndexField: 0, InstanceVolumeSizeField: float64(10), InstanceVolumesAttachmentTagField: "",
+	}}
+	if !reflect.DeepEqual(target[InstanceBootVolumesField], wantBoot) {
+		t.Errorf("boot_volumes = %#v, want %#v", target[InstanceBootVolumesField], wantBoot)
+	}
+	wantData := []interface{}{map[string]interface{}{
+		InstanceVolumeIDField: "data", InstanceVolumeSizeField: float64(5), InstanceVolumesAttachmentTagField: "",
+	}}
+	if !reflect.DeepEqual(target[InstanceDataVolumesField], wantData) {
+		t.Errorf("data_volumes = %#v, want %#v", target[InstanceDataVolumesField], wantData)
+	}
+
+	if _, err := moveInstanceStateToV2(map[string]interface{}{}); err == nil {
+		t.Error("a state without id must not be moved")
+	}
+}
+
+func TestMoveLoadBalancerStateToV2(t *testing.T) {
+	source := decodeRawState(t, `{"id": "lb", "name": "web", "vip_network_id": "net", "listener": [{"name": "http"}]}`)
+
+	target, err := moveLoadBalancerStateToV2(source)
+	if err != nil {
+		t.Fatalf("move: %v", err)
+	}
+
+	want := map[string]interface{}{"id": "lb", "name": "web"}
+	if !reflect.DeepEqual(target, want) {
+		t.Errorf("state = %v, want %v", target, want)
+	}
+}
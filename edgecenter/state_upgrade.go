@@ -0,0 +1,17 @@
+package edgecenter
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// StateUpgraderFromSuperset returns the upgrader of state at version to the next version of r.
+// It fits upgrades that only fill in attributes added later or drop null block items, so the
+// current schema type of r decodes the old state as well. The type is only used for state
+// stored in the legacy flatmap format, so r must have its schema set, but not its upgraders.
+func StateUpgraderFromSuperset(version int, r *schema.Resource, upgrade schema.StateUpgradeFunc) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    r.CoreConfigSchema().ImpliedType(),
+		Upgrade: upgrade,
+	}
+}
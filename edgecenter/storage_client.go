@@ -0,0 +1,57 @@
+package edgecenter
+
+import (
+	"net/http"
+
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/client/buckets"
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/client/locations"
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/client/storages"
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/models"
+)
+
+// storageHTTPClient sends the requests of the storage SDK through an HTTP client of the provider.
+// The SDK builds its transport internally, but every generated operation takes the client to use
+// in its parameters, and the client set there replaces the internal one.
+type storageHTTPClient struct {
+	sdk    StorageClientService
+	client *http.Client
+}
+
+var _ StorageClientService = (*storageHTTPClient)(nil)
+
+func newStorageHTTPClient(sdk StorageClientService, client *http.Client) *storageHTTPClient {
+	return &storageHTTPClient{sdk: sdk, client: client}
+}
+
+// withHTTPClient puts the option setting the client in front of opts, so the caller can still override it.
+func withHTTPClient[P interface{ SetHTTPClient(*http.Client) }](client *http.Client, opts []func(P)) []func(P) {
+	return append([]func(P){func(params P) { params.SetHTTPClient(client) }}, opts...)
+}
+
+func (c *storageHTTPClient) LocationsList(opts ...func(params *locations.LocationListHTTPParams)) ([]models.ClientLocationRes, error) {
+	return c.sdk.LocationsList(withHTTPClient(c.client, opts)...) //nolint:wrapcheck
+}
+
+func (c *storageHTTPClient) StoragesList(opts ...func(params *storages.StorageListHTTPV2Params)) ([]models.Storage, error) {
+	return c.sdk.StoragesList(withHTTPClient(c.client, opts)...) //nolint:wrapcheck
+}
+
+func (c *storageHTTPClient) CreateStorage(opts ...func(params *storages.StorageCreateHTTPParams)) (*models.Storage, error) {
+	return c.sdk.CreateStorage(withHTTPClient(c.client, opts)...) //nolint:wrapcheck
+}
+
+func (c *storageHTTPClient) DeleteStorage(opts ...func(params *storages.StorageDeleteHTTPParams)) error {
+	return c.sdk.DeleteStorage(withHTTPClient(c.client, opts)...) //nolint:wrapcheck
+}
+
+func (c *storageHTTPClient) BucketsList(opts ...func(params *buckets.StorageListBucketsHTTPParams)) ([]models.BucketDto, error) {
+	return c.sdk.BucketsList(withHTTPClient(c.client, opts)...) //nolint:wrapcheck
+}
+
+func (c *storageHTTPClient) CreateBucket(opts ...func(params *buckets.StorageBucketCreateHTTPParams)) error {
+	return c.sdk.CreateBucket(withHTTPClient(c.client, opts)...) //nolint:wrapcheck
+}
+
+func (c *storageHTTPClient) DeleteBucket(opts ...func(params *buckets.StorageBucketRemoveHTTPParams)) error {
+	return c.sdk.DeleteBucket(withHTTPClient(c.client, opts)...) //nolint:wrapcheck
+}
@@ -0,0 +1,46 @@
+package edgecenter
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/client/locations"
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/models"
+)
+
+// fakeStorageSDK applies the options to fresh parameters, as the SDK does before sending a request.
+type fakeStorageSDK struct {
+	StorageClientService
+
+	params *locations.LocationListHTTPParams
+}
+
+func (f *fakeStorageSDK) LocationsList(opts ...func(params *locations.LocationListHTTPParams)) ([]models.ClientLocationRes, error) {
+	f.params = locations.NewLocationListHTTPParams()
+	for _, opt := range opts {
+		opt(f.params)
+	}
+
+	return nil, nil
+}
+
+func TestStorageHTTPClient(t *testing.T) {
+	sdk := &fakeStorageSDK{}
+	shared := &http.Client{}
+	client := newStorageHTTPClient(sdk, shared)
+
+	if _, err := client.LocationsList(); err != nil {
+		t.Fatal(err)
+	}
+	if sdk.params.HTTPClient != shared {
+		t.Error("the request does not go through the shared client")
+	}
+
+	own := &http.Client{}
+	if _, err := client.LocationsList(func(params *locations.LocationListHTTPParams) { params.SetHTTPClient(own) }); err != nil {
+		t.Fatal(err)
+	}
+	if sdk.params.HTTPClient != own {
+		t.Error("the client set by the caller was replaced")
+	}
+}
@@ -7,8 +7,11 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/mapstructure"
 
@@ -50,39 +53,52 @@ func findRegionByNameLegacy(arr []regions.Region, name string) (int, error) {
 }
 
 // GetRegionLegacy to support backwards compatibility.
-func GetRegionLegacy(provider *edgecloud.ProviderClient, regionID int, regionName string) (int, error) {
+func GetRegionLegacy(config *Config, regionID int, regionName string) (int, error) {
 	if regionID != 0 {
 		return regionID, nil
 	}
-	client, err := edgecenter.ClientServiceFromProvider(provider, edgecloud.EndpointOpts{
-		Name:    RegionPoint,
-		Region:  0,
-		Project: 0,
-		Version: VersionPointV1,
-	})
-	if err != nil {
-		return 0, err
-	}
 
-	rs, err := regions.ListAll(client)
-	if err != nil {
-		return 0, err
-	}
-	log.Printf("[DEBUG] Regions: %v", rs)
-	regionID, err = findRegionByNameLegacy(rs, regionName)
-	if err != nil {
-		return 0, err
-	}
-	log.Printf("[DEBUG] The attempt to get the region is successful: regionID=%d", regionID)
+	return config.names.resolve(regionCacheKey(0, regionName), func() (int, error) {
+		client, err := edgecenter.ClientServiceFromProvider(config.Provider, edgecloud.EndpointOpts{
+			Name:    RegionPoint,
+			Region:  0,
+			Project: 0,
+			Version: VersionPointV1,
+		})
+		if err != nil {
+			return 0, err
+		}
 
-	return regionID, nil
+		rs, err := regions.ListAll(client)
+		if err != nil {
+			return 0, err
+		}
+		log.Printf("[DEBUG] Regions: %v", rs)
+		regionID, err := findRegionByNameLegacy(rs, regionName)
+		if err != nil {
+			return 0, err
+		}
+		log.Printf("[DEBUG] The attempt to get the region is successful: regionID=%d", regionID)
+
+		return regionID, nil
+	})
 }
 
 // CreateClient creates a new edgecloud.ServiceClient.
-func CreateClient(provider *edgecloud.ProviderClient, d *schema.ResourceData, endpoint string, version string) (*edgecloud.ServiceClient, error) {
-	projectID, err := GetProject(provider, d.Get("project_id").(int), d.Get("project_name").(string))
-	if err != nil {
-		return nil, err
+func CreateClient(config *Config, d *schema.ResourceData, endpoint string, version string) (*edgecloud.ServiceClient, error) {
+	applyProviderDefault(d, ProjectIDField, ProjectNameField, config.DefaultProjectID, config.DefaultProjectName)
+	applyProviderDefault(d, RegionIDField, RegionNameField, config.DefaultRegionID, config.DefaultRegionName)
+
+	projectID := d.Get("project_id").(int)
+	if projectID == 0 {
+		projectName := d.Get("project_name").(string)
+		var err error
+		projectID, err = config.names.resolve(projectCacheKey(0, projectName), func() (int, error) {
+			return GetProject(config.Provider, 0, projectName)
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	regionID := 0
@@ -91,13 +107,14 @@ func CreateClient(provider *edgecloud.ProviderClient, d *schema.ResourceData, en
 	rawRegionName := d.Get("region_name")
 
 	if rawRegionID != nil && rawRegionName != nil {
-		regionID, err = GetRegionLegacy(provider, rawRegionID.(int), rawRegionName.(string))
+		var err error
+		regionID, err = GetRegionLegacy(config, rawRegionID.(int), rawRegionName.(string))
 		if err != nil {
 			return nil, fmt.Errorf("failed to get region: %w", err)
 		}
 	}
 
-	client, err := edgecenter.ClientServiceFromProvider(provider, edgecloud.EndpointOpts{
+	client, err := edgecenter.ClientServiceFromProvider(config.Provider, edgecloud.EndpointOpts{
 		Name:    endpoint,
 		Region:  regionID,
 		Project: projectID,
@@ -148,20 +165,42 @@ func ExtractHostAndPath(uri string) (string, string, error) {
 	return host, path, nil
 }
 
+// applyProviderDefault sets the provider-level default for an ID/name identity pair
+// when the resource specifies neither of them. It reports whether a default was applied.
+// Resources without the pair in their schema are left untouched.
+func applyProviderDefault(d *schema.ResourceData, idField, nameField string, defaultID int, defaultName string) bool {
+	if _, ok := d.GetOk(idField); ok {
+		return false
+	}
+	if _, ok := d.GetOk(nameField); ok {
+		return false
+	}
+
+	switch {
+	case defaultID != 0:
+		return d.Set(idField, defaultID) == nil
+	case defaultName != "":
+		return d.Set(nameField, defaultName) == nil
+	}
+
+	return false
+}
+
 // GetRegionIDandProjectID search for project ID and region ID by name or return project ID
 // and region ID if they exist in the terraform configuration.
 // Use new version Edgecenterclient-go V2.
 // nolint: nonamedreturns
 func GetRegionIDandProjectID(
 	ctx context.Context,
+	config *Config,
 	client *edgecloudV2.Client,
 	d *schema.ResourceData,
 ) (regionID int, projectID int, err error) {
-	regionID, err = GetRegionID(ctx, client, d)
+	regionID, err = GetRegionID(ctx, config, client, d)
 	if err != nil {
 		return 0, 0, err
 	}
-	projectID, err = GetProjectID(ctx, client, d)
+	projectID, err = GetProjectID(ctx, config, client, d)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -171,6 +210,7 @@ func GetRegionIDandProjectID(
 
 func GetRegionID(
 	ctx context.Context,
+	config *Config,
 	client *edgecloudV2.Client,
 	d *schema.ResourceData,
 ) (int, error) {
@@ -181,7 +221,7 @@ func GetRegionID(
 	rName, NameOk := d.GetOk("region_name")
 
 	if !IDOk && !NameOk {
-		return 0, fmt.Errorf("both parameters and region_id and region_name are not provided")
+		return 0, fmt.Errorf("neither region_id nor region_name is provided in the resource or the provider configuration")
 	}
 
 	if IDOk {
@@ -199,7 +239,9 @@ func GetRegionID(
 		}
 	}
 
-	regionID, err := GetRegionV2(ctx, client, regionID, regionName)
+	regionID, err := config.names.resolve(regionCacheKey(regionID, regionName), func() (int, error) {
+		return GetRegionV2(ctx, client, regionID, regionName)
+	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to get region: %w", err)
 	}
@@ -209,6 +251,7 @@ func GetRegionID(
 
 func GetProjectID(
 	ctx context.Context,
+	config *Config,
 	client *edgecloudV2.Client,
 	d *schema.ResourceData,
 ) (int, error) {
@@ -219,7 +262,7 @@ func GetProjectID(
 	pName, NameOk := d.GetOk("project_name")
 
 	if !IDOk && !NameOk {
-		return 0, fmt.Errorf("both parameters and project_id and project_name are not provided")
+		return 0, fmt.Errorf("neither project_id nor project_name is provided in the resource or the provider configuration")
 	}
 
 	if IDOk {
@@ -228,21 +271,189 @@ func GetProjectID(
 
 	if NameOk {
 		projectName = pName.(string)
+	}
 
-		if projectID == 0 {
-			if parsedID, err := strconv.Atoi(projectName); err == nil {
-				projectID = parsedID
-				projectName = ""
-			}
+	return resolveProjectID(ctx, config, client, projectID, projectName)
+}
+
+// resolveProjectID returns the ID of the project given by its ID or its name, a numeric name is taken as the ID.
+func resolveProjectID(ctx context.Context, config *Config, client *edgecloudV2.Client, projectID int, projectName string) (int, error) {
+	if projectID == 0 {
+		if parsedID, err := strconv.Atoi(projectName); err == nil {
+			projectID = parsedID
+			projectName = ""
+		}
+	}
+
+	return config.names.resolve(projectCacheKey(projectID, projectName), func() (int, error) {
+		project, err := GetProjectV2(ctx, client, projectID, projectName)
+		if err != nil {
+			return 0, err
+		}
+
+		return project.ID, nil
+	})
+}
+
+// planScopeValue returns the id or the name of the project or the region a planned resource belongs to,
+// falling back to the provider defaults. It returns false while the value is not known yet.
+func planScopeValue(d *schema.ResourceDiff, idField, nameField string, defaultID int, defaultName string) (int, string, bool) {
+	if d.Id() != "" {
+		if id := d.Get(idField).(int); id != 0 {
+			return id, "", true
+		}
+	}
+
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return 0, "", false
+	}
+
+	id, name := raw.GetAttr(idField), raw.GetAttr(nameField)
+	if !id.IsKnown() || !name.IsKnown() {
+		return 0, "", false
+	}
+	if !id.IsNull() && id.Type() == cty.Number {
+		v, _ := id.AsBigFloat().Int64()
+		return int(v), "", true
+	}
+	if !name.IsNull() && name.Type() == cty.String {
+		return 0, name.AsString(), true
+	}
+
+	return defaultID, defaultName, true
+}
+
+// planCloudClient returns a cloud client for the project and the region of a planned resource,
+// for the CustomizeDiff functions that look up objects at plan time. It returns false while the
+// project or the region is not known yet.
+func planCloudClient(d *schema.ResourceDiff, config *Config) (*edgecloudV2.Client, bool, error) {
+	projectID, projectName, ok := planScopeValue(d, ProjectIDField, ProjectNameField, config.DefaultProjectID, config.DefaultProjectName)
+	if !ok {
+		return nil, false, nil
+	}
+	regionID, regionName, ok := planScopeValue(d, RegionIDField, RegionNameField, config.DefaultRegionID, config.DefaultRegionName)
+	if !ok || (projectID == 0 && projectName == "") || (regionID == 0 && regionName == "") {
+		return nil, false, nil
+	}
+
+	var err error
+	if projectID == 0 {
+		projectID, err = config.names.resolve(projectCacheKey(0, projectName), func() (int, error) {
+			return GetProject(config.Provider, 0, projectName)
+		})
+		if err != nil {
+			return nil, false, err
 		}
 	}
+	regionID, err = GetRegionLegacy(config, regionID, regionName)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get region: %w", err)
+	}
 
-	project, err := GetProjectV2(ctx, client, projectID, projectName)
+	client, err := config.NewCloudClient()
 	if err != nil {
-		return 0, err
+		return nil, false, err
 	}
+	client.Project = projectID
+	client.Region = regionID
+
+	return client, true, nil
+}
 
-	return project.ID, nil
+// WithProviderScopeDiff adds providerScopeDiff to a resource that has a project or a region pair.
+func WithProviderScopeDiff(r *schema.Resource) {
+	if !providerScopeField(r, ProjectIDField) && !providerScopeField(r, RegionIDField) {
+		return
+	}
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = providerScopeDiff
+		return
+	}
+	r.CustomizeDiff = customdiff.All(providerScopeDiff, r.CustomizeDiff)
+}
+
+// providerScopeField reports whether the planned value of the id field can be set by providerScopeDiff.
+func providerScopeField(r *schema.Resource, idField string) bool {
+	s, ok := r.Schema[idField]
+
+	return ok && s.Computed && s.Type == schema.TypeInt
+}
+
+// providerScopeDiff plans the replacement of a resource that took its project or region from the
+// provider defaults when the defaults have changed since, as the objects can't be moved to another
+// project or region and would otherwise silently stay in the old one.
+func providerScopeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config, ok := m.(*Config)
+	if !ok || d.Id() == "" {
+		return nil
+	}
+
+	err := planProviderDefaultChange(d, ProjectIDField, ProjectNameField, config.DefaultProjectID, config.DefaultProjectName,
+		func(name string) (int, error) {
+			return config.names.resolve(projectCacheKey(0, name), func() (int, error) {
+				return GetProject(config.Provider, 0, name)
+			})
+		})
+	if err != nil {
+		return fmt.Errorf("default project: %w", err)
+	}
+
+	err = planProviderDefaultChange(d, RegionIDField, RegionNameField, config.DefaultRegionID, config.DefaultRegionName,
+		func(name string) (int, error) {
+			return GetRegionLegacy(config, 0, name)
+		})
+	if err != nil {
+		return fmt.Errorf("default region: %w", err)
+	}
+
+	return nil
+}
+
+// planProviderDefaultChange forces a new resource when neither field of the pair is configured and
+// the id in state differs from the one the provider default resolves to.
+func planProviderDefaultChange(
+	d *schema.ResourceDiff, idField, nameField string, defaultID int, defaultName string, resolve func(string) (int, error),
+) error {
+	if defaultID == 0 && defaultName == "" {
+		return nil
+	}
+
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	rawType := raw.Type()
+	if !rawType.IsObjectType() || !rawType.HasAttribute(idField) || !rawType.HasAttribute(nameField) {
+		return nil
+	}
+	if !raw.GetAttr(idField).IsNull() || !raw.GetAttr(nameField).IsNull() {
+		return nil
+	}
+
+	old, _ := d.GetChange(idField)
+	stateID, _ := old.(int)
+	if stateID == 0 {
+		return nil
+	}
+
+	wantID := defaultID
+	if wantID == 0 {
+		var err error
+		if wantID, err = resolve(defaultName); err != nil {
+			return err
+		}
+	}
+	if wantID == stateID {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Resource %s is in %s %d, the provider default is %d now", d.Id(), idField, stateID, wantID)
+	if err := d.SetNew(idField, wantID); err != nil {
+		return err
+	}
+
+	return d.ForceNew(idField)
 }
 
 func validateURLFunc(v interface{}, attributeName string) (warnings []string, errors []error) { //nolint:nonamedreturns
@@ -260,6 +471,23 @@ func validateURLFunc(v interface{}, attributeName string) (warnings []string, er
 	return warnings, errors
 }
 
+func validateDurationFunc(v interface{}, attributeName string) (warnings []string, errors []error) { //nolint:nonamedreturns
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", attributeName))
+		return
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s is not a valid duration: %s", attributeName, err.Error()))
+	} else if d <= 0 {
+		errors = append(errors, fmt.Errorf("%s must be positive, got %s", attributeName, value))
+	}
+
+	return warnings, errors
+}
+
 // Filter iterates over elements of the collection, returning a slice of
 // all elements for which the predicate returns true.
 // - arr []T is a slice of elements of type T.
@@ -0,0 +1,241 @@
+package edgecenter
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// cloudListPageSize is the page size of the list requests of the paginated cloud APIs.
+const cloudListPageSize = 1000
+
+// listDataSourceSchema returns the schema of a plural data source: the project and the region,
+// the filters and the computed list of objects in itemsField, shaped by item.
+// The status filter is added only when withStatus is set.
+func listDataSourceSchema(itemsField, itemsDescription string, item *schema.Resource, withStatus bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		ProjectIDField: {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			Computed:      true,
+			Description:   "The uuid of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+			ConflictsWith: []string{ProjectNameField},
+		},
+		ProjectNameField: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			Description:   "The name of the project. Either 'project_id' or 'project_name' must be specified here or in the provider configuration.",
+			ConflictsWith: []string{ProjectIDField},
+		},
+		RegionIDField: {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			Computed:      true,
+			Description:   "The uuid of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+			ConflictsWith: []string{RegionNameField},
+		},
+		RegionNameField: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			Description:   "The name of the region. Either 'region_id' or 'region_name' must be specified here or in the provider configuration.",
+			ConflictsWith: []string{RegionIDField},
+		},
+		NameRegexField: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "A regular expression the name must match, e.g. '^web-'.",
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		MetadataKField: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return objects with this metadata key.",
+		},
+		MetadataKVField: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Only return objects with all these metadata key-value pairs, for example, {backup = \"daily\"}.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		itemsField: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: itemsDescription,
+			Elem:        item,
+		},
+	}
+	if withStatus {
+		s[StatusField] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return objects with this status.",
+		}
+	}
+
+	return s
+}
+
+// computedElem copies the schema of a singular data source into the element of the list of the
+// plural one. Every attribute becomes computed; the lookup and filter attributes in drop are left out.
+func computedElem(src map[string]*schema.Schema, drop ...string) *schema.Resource {
+	dst := make(map[string]*schema.Schema, len(src))
+	for name, s := range src {
+		if slices.Contains(drop, name) {
+			continue
+		}
+		dst[name] = computedCopy(s)
+	}
+
+	return &schema.Resource{Schema: dst}
+}
+
+func computedCopy(s *schema.Schema) *schema.Schema {
+	c := &schema.Schema{
+		Type:        s.Type,
+		Computed:    true,
+		Description: s.Description,
+		Sensitive:   s.Sensitive,
+		Set:         s.Set,
+	}
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		c.Elem = computedElem(elem.Schema)
+	case *schema.Schema:
+		c.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return c
+}
+
+// listFilter holds the filters of a plural data source. They are applied to every object the API
+// returns, also when the API filters by metadata itself, so that all list APIs behave the same.
+type listFilter struct {
+	nameRegex  *regexp.Regexp
+	status     string
+	metadataK  string
+	metadataKV map[string]string
+}
+
+func expandListFilter(d *schema.ResourceData) (*listFilter, error) {
+	f := &listFilter{}
+
+	if v, ok := d.GetOk(NameRegexField); ok {
+		re, err := regexp.Compile(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", NameRegexField, err)
+		}
+		f.nameRegex = re
+	}
+	if v, ok := d.GetOk(StatusField); ok {
+		f.status = v.(string)
+	}
+	if v, ok := d.GetOk(MetadataKField); ok {
+		f.metadataK = v.(string)
+	}
+	if v, ok := d.GetOk(MetadataKVField); ok {
+		kv, err := MapInterfaceToMapString(v)
+		if err != nil {
+			return nil, err
+		}
+		f.metadataKV = *kv
+	}
+
+	return f, nil
+}
+
+// metadataKVQuery returns the metadata_kv filter in the JSON form of the list APIs.
+func (f *listFilter) metadataKVQuery() (string, error) {
+	if len(f.metadataKV) == 0 {
+		return "", nil
+	}
+	kv, err := json.Marshal(f.metadataKV)
+	if err != nil {
+		return "", err
+	}
+
+	return string(kv), nil
+}
+
+func (f *listFilter) matchName(name string) bool {
+	return f.nameRegex == nil || f.nameRegex.MatchString(name)
+}
+
+func (f *listFilter) matchStatus(status string) bool {
+	return f.status == "" || f.status == status
+}
+
+func (f *listFilter) matchMetadata(metadata map[string]string) bool {
+	if f.metadataK != "" {
+		if _, ok := metadata[f.metadataK]; !ok {
+			return false
+		}
+	}
+	for k, v := range f.metadataKV {
+		if value, ok := metadata[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *listFilter) match(name, status string, metadata map[string]string) bool {
+	return f.matchName(name) && f.matchStatus(status) && f.matchMetadata(metadata)
+}
+
+// metadataDetailedToMap returns all metadata of an object, including the read-only items.
+func metadataDetailedToMap(metadata []edgecloudV2.MetadataDetailed) map[string]string {
+	res := make(map[string]string, len(metadata))
+	for _, item := range metadata {
+		res[item.Key] = item.Value
+	}
+
+	return res
+}
+
+// listAllPages calls list with a growing offset until it returns a page shorter than the page size.
+func listAllPages[T any](list func(limit, offset int) ([]T, error)) ([]T, error) {
+	var all []T
+	for offset := 0; ; {
+		page, err := list(cloudListPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if len(page) < cloudListPageSize {
+			return all, nil
+		}
+		offset += len(page)
+	}
+}
+
+// setDataSourceFields sets the attributes of a singular data source from the map its plural
+// counterpart uses for one object of the list.
+func setDataSourceFields(d *schema.ResourceData, fields map[string]interface{}) error {
+	for name, v := range fields {
+		if err := d.Set(name, v); err != nil {
+			return fmt.Errorf("cannot set %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// setListDataSource sets the id of a plural data source and its list of objects.
+func setListDataSource(d *schema.ResourceData, clientV2 *edgecloudV2.Client, itemsField string, items []map[string]interface{}) error {
+	d.SetId(fmt.Sprintf("%d:%d", clientV2.Project, clientV2.Region))
+	_ = d.Set(ProjectIDField, clientV2.Project)
+	_ = d.Set(RegionIDField, clientV2.Region)
+
+	return d.Set(itemsField, items)
+}
@@ -0,0 +1,78 @@
+package edgecenter
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestListFilterMatch(t *testing.T) {
+	f := &listFilter{
+		nameRegex:  regexp.MustCompile("^web-"),
+		status:     "ACTIVE",
+		metadataK:  "team",
+		metadataKV: map[string]string{"env": "prod"},
+	}
+	metadata := map[string]string{"team": "infra", "env": "prod"}
+
+	tests := []struct {
+		name     string
+		objName  string
+		status   string
+		metadata map[string]string
+		want     bool
+	}{
+		{"all match", "web-1", "ACTIVE", metadata, true},
+		{"name mismatch", "db-1", "ACTIVE", metadata, false},
+		{"status mismatch", "web-1", "SHUTOFF", metadata, false},
+		{"key missing", "web-1", "ACTIVE", map[string]string{"env": "prod"}, false},
+		{"value mismatch", "web-1", "ACTIVE", map[string]string{"team": "infra", "env": "dev"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := f.match(tt.objName, tt.status, tt.metadata); got != tt.want {
+				t.Errorf("match = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListFilterEmptyMatchesAll(t *testing.T) {
+	f := &listFilter{}
+	if !f.match("", "", nil) {
+		t.Error("expected an empty filter to match everything")
+	}
+	kv, err := f.metadataKVQuery()
+	if err != nil || kv != "" {
+		t.Errorf("metadataKVQuery = %q, %v, want an empty query", kv, err)
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	total := 2*cloudListPageSize + 3
+	var offsets []int
+	all, err := listAllPages(func(limit, offset int) ([]int, error) {
+		offsets = append(offsets, offset)
+		n := min(limit, total-offset)
+		return make([]int, n), nil
+	})
+	if err != nil {
+		t.Fatalf("listAllPages: %v", err)
+	}
+	if len(all) != total {
+		t.Errorf("got %d items, want %d", len(all), total)
+	}
+	if want := []int{0, cloudListPageSize, 2 * cloudListPageSize}; !slices.Equal(offsets, want) {
+		t.Errorf("offsets = %v, want %v", offsets, want)
+	}
+}
+
+func TestListAllPagesError(t *testing.T) {
+	_, err := listAllPages(func(int, int) ([]int, error) {
+		return nil, errors.New("unavailable")
+	})
+	if err == nil {
+		t.Error("expected the list error")
+	}
+}
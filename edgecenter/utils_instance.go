@@ -1289,6 +1289,45 @@ func prepareDataVolumesDataFromAPI(instanceVolumes []edgecloudV2.Volume) []inter
 	return enrichedDataVolumesData
 }
 
+// updateInstanceMetadata writes the metadata an instance resource sets, including the provider
+// defaults, and deletes the keys it set before but no longer does.
+func updateInstanceMetadata(ctx context.Context, clientV2 *edgecloudV2.Client, instanceID string, d *schema.ResourceData, m interface{}, fields metadataFields) error {
+	merged := fields.merged(d, m)
+
+	oldKeys := make(map[string]struct{})
+	oldAll, _ := d.GetChange(MetadataAllField)
+	oldMap, _ := d.GetChange(fields.mapField)
+	for _, md := range []interface{}{oldAll, oldMap} {
+		for k := range md.(map[string]interface{}) {
+			oldKeys[k] = struct{}{}
+		}
+	}
+	if fields.listField != "" {
+		oldList, _ := d.GetChange(fields.listField)
+		for _, item := range oldList.([]interface{}) {
+			oldKeys[item.(map[string]interface{})[KeyField].(string)] = struct{}{}
+		}
+	}
+
+	for k := range oldKeys {
+		if _, ok := merged[k]; ok {
+			continue
+		}
+		if _, err := clientV2.Instances.MetadataDeleteItem(ctx, instanceID, &edgecloudV2.MetadataItemOptions{Key: k}); err != nil {
+			return fmt.Errorf("cannot delete metadata key: %s. Error: %w", k, err)
+		}
+	}
+
+	if len(merged) > 0 {
+		metadata := edgecloudV2.Metadata(merged)
+		if _, err := clientV2.Instances.MetadataCreate(ctx, instanceID, &metadata); err != nil {
+			return fmt.Errorf("cannot create metadata. Error: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func prepareMetadataFromAPI(ctx context.Context, clientV2 *edgecloudV2.Client, instanceID string) (map[string]interface{}, error) {
 	newMetadata := make(map[string]interface{})
 
@@ -0,0 +1,457 @@
+package edgecenter
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+// instanceGroupIP is an IP address of a member of an instance group.
+type instanceGroupIP struct {
+	subnetID string
+	address  string
+}
+
+// instanceGroupMember is a member of an instance group as stored in the members attribute.
+type instanceGroupMember struct {
+	id     string
+	name   string
+	status string
+	ips    []instanceGroupIP
+	// poolMemberIDs are the IDs of the load balancer pool members of the instance, keyed by the pool ID.
+	poolMemberIDs map[string]string
+}
+
+// address returns the address of the member in the subnet, or the first address when subnetID is empty.
+func (m *instanceGroupMember) address(subnetID string) (string, error) {
+	for _, ip := range m.ips {
+		if subnetID == "" || ip.subnetID == subnetID {
+			return ip.address, nil
+		}
+	}
+	if subnetID == "" {
+		return "", fmt.Errorf("instance %s has no IP address", m.id)
+	}
+
+	return "", fmt.Errorf("instance %s has no IP address in subnet %s", m.id, subnetID)
+}
+
+type instanceGroupPool struct {
+	poolID       string
+	protocolPort int
+	weight       int
+	subnetID     string
+}
+
+type instanceGroupBootVolume struct {
+	imageID  string
+	size     int
+	typeName string
+}
+
+func expandInstanceGroupBootVolume(raw interface{}) instanceGroupBootVolume {
+	items, _ := raw.([]interface{})
+	if len(items) == 0 || items[0] == nil {
+		return instanceGroupBootVolume{}
+	}
+	v := items[0].(map[string]interface{})
+
+	return instanceGroupBootVolume{
+		imageID:  v[InstanceGroupImageIDField].(string),
+		size:     v[InstanceVolumeSizeField].(int),
+		typeName: v[TypeNameField].(string),
+	}
+}
+
+func expandInstanceGroupPools(raw interface{}) []instanceGroupPool {
+	items, _ := raw.([]interface{})
+	pools := make([]instanceGroupPool, 0, len(items))
+	for _, item := range items {
+		v := item.(map[string]interface{})
+		pools = append(pools, instanceGroupPool{
+			poolID:       v[InstanceGroupPoolIDField].(string),
+			protocolPort: v[InstanceGroupProtocolPortField].(int),
+			weight:       v[InstanceGroupWeightField].(int),
+			subnetID:     v[SubnetIDField].(string),
+		})
+	}
+
+	return pools
+}
+
+func expandInstanceGroupMembers(raw interface{}) []*instanceGroupMember {
+	items, _ := raw.([]interface{})
+	members := make([]*instanceGroupMember, 0, len(items))
+	for _, item := range items {
+		v := item.(map[string]interface{})
+		member := &instanceGroupMember{
+			id:            v[IDField].(string),
+			name:          v[NameField].(string),
+			status:        v[StatusField].(string),
+			poolMemberIDs: make(map[string]string),
+		}
+		for poolID, memberID := range v[InstanceGroupPoolMemberIDsField].(map[string]interface{}) {
+			member.poolMemberIDs[poolID] = memberID.(string)
+		}
+		for _, address := range v[InstanceGroupIPAddressesField].([]interface{}) {
+			member.ips = append(member.ips, instanceGroupIP{address: address.(string)})
+		}
+		members = append(members, member)
+	}
+
+	return members
+}
+
+func setInstanceGroupMembers(d *schema.ResourceData, members []*instanceGroupMember) {
+	items := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		addresses := make([]string, 0, len(member.ips))
+		for _, ip := range member.ips {
+			addresses = append(addresses, ip.address)
+		}
+		firstAddress := ""
+		if len(addresses) > 0 {
+			firstAddress = addresses[0]
+		}
+		items = append(items, map[string]interface{}{
+			IDField:                         member.id,
+			NameField:                       member.name,
+			StatusField:                     member.status,
+			IPAddressField:                  firstAddress,
+			InstanceGroupIPAddressesField:   addresses,
+			InstanceGroupPoolMemberIDsField: member.poolMemberIDs,
+		})
+	}
+	if err := d.Set(InstanceGroupMembersField, items); err != nil {
+		log.Printf("[ERROR] cannot set %s: %s", InstanceGroupMembersField, err)
+	}
+}
+
+// readInstanceGroupMember returns the member and its instance, or nil when the instance does not exist.
+func readInstanceGroupMember(ctx context.Context, clientV2 *edgecloudV2.Client, instanceID string) (*instanceGroupMember, *edgecloudV2.Instance, error) {
+	instance, resp, err := clientV2.Instances.Get(ctx, instanceID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	ifaces, _, err := clientV2.Instances.InterfaceList(ctx, instanceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := &instanceGroupMember{
+		id:            instance.ID,
+		name:          instance.Name,
+		status:        string(instance.Status),
+		poolMemberIDs: make(map[string]string),
+	}
+	for _, iface := range ifaces {
+		for _, assignment := range iface.IPAssignments {
+			member.ips = append(member.ips, instanceGroupIP{subnetID: assignment.SubnetID, address: assignment.IPAddress.String()})
+		}
+	}
+
+	return member, instance, nil
+}
+
+// createInstanceGroupMembers creates count members in a single request and returns them in the order of creation.
+// When the request fails, the instances it created anyway are deleted, and those that cannot be deleted are returned
+// with the error, so they are kept in the state instead of leaking.
+func createInstanceGroupMembers(
+	ctx context.Context,
+	clientV2 *edgecloudV2.Client,
+	d *schema.ResourceData,
+	m interface{},
+	count int,
+	timeout time.Duration,
+) ([]*instanceGroupMember, error) {
+	bootVolume := expandInstanceGroupBootVolume(d.Get(InstanceGroupBootVolumeField))
+	bootIndex := 0
+
+	createOpts := edgecloudV2.InstanceCreateRequest{
+		Flavor:           d.Get(FlavorIDField).(string),
+		KeypairName:      d.Get(InstanceKeypairNameField).(string),
+		ServerGroupID:    d.Get(InstanceServerGroupField).(string),
+		AvailabilityZone: d.Get(AvailabilityZoneField).(string),
+		NameTemplates:    make([]string, count),
+		Volumes: []edgecloudV2.InstanceVolumeCreate{{
+			Source:    edgecloudV2.VolumeSourceImage,
+			BootIndex: &bootIndex,
+			ImageID:   bootVolume.imageID,
+			Size:      bootVolume.size,
+			TypeName:  edgecloudV2.VolumeType(bootVolume.typeName),
+		}},
+	}
+	for i := range createOpts.NameTemplates {
+		createOpts.NameTemplates[i] = d.Get(InstanceNameTemplateField).(string)
+	}
+
+	if userData, ok := d.GetOk(InstanceUserDataField); ok {
+		createOpts.UserData = base64.StdEncoding.EncodeToString([]byte(userData.(string)))
+	}
+
+	defaultSG, err := utilV2.FindDefaultSG(ctx, clientV2)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range d.Get(InstanceInterfacesField).([]interface{}) {
+		iface := item.(map[string]interface{})
+		createOpts.Interfaces = append(createOpts.Interfaces, edgecloudV2.InstanceInterface{
+			Type:           edgecloudV2.InterfaceType(iface[TypeField].(string)),
+			NetworkID:      iface[NetworkIDField].(string),
+			SubnetID:       iface[SubnetIDField].(string),
+			SecurityGroups: []edgecloudV2.ID{{ID: defaultSG.ID}},
+		})
+	}
+
+	if metadata := instanceGroupMetadata.merged(d, m); len(metadata) > 0 {
+		createOpts.Metadata = metadata
+	}
+
+	log.Printf("[DEBUG] Instance group create options: %+v", createOpts)
+
+	results, _, err := clientV2.Instances.Create(ctx, &createOpts)
+	if err != nil {
+		return nil, fmt.Errorf("error from creating %d instances: %w", count, err)
+	}
+	taskID := results.Tasks[0]
+	log.Printf("[DEBUG] Task id (%s)", taskID)
+	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, timeout)
+	if err == nil && task.State == edgecloudV2.TaskStateError {
+		err = fmt.Errorf("task %s failed", taskID)
+	}
+	if err != nil {
+		if task == nil {
+			task, _, _ = clientV2.Tasks.Get(ctx, taskID)
+		}
+		// A failed task still lists the instances it created before the failure.
+		var instanceIDs []string
+		if task != nil {
+			if taskResult, extractErr := utilV2.ExtractTaskResultFromTask(task); extractErr == nil {
+				instanceIDs = taskResult.Instances
+			}
+		}
+		return discardInstanceGroupMembers(ctx, clientV2, instanceIDs, fmt.Errorf("error from creating %d instances: %w", count, err))
+	}
+
+	taskResult, err := utilV2.ExtractTaskResultFromTask(task)
+	if err != nil {
+		return nil, err
+	}
+	instanceIDs := taskResult.Instances
+
+	members := make([]*instanceGroupMember, 0, len(instanceIDs))
+	for _, instanceID := range instanceIDs {
+		member, _, err := readInstanceGroupMember(ctx, clientV2, instanceID)
+		if err != nil {
+			return members, err
+		}
+		if member == nil {
+			return members, fmt.Errorf("created instance %s not found", instanceID)
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// discardInstanceGroupMembers deletes the instances created by a failed request and returns createErr. The
+// instances that cannot be deleted are returned as members.
+func discardInstanceGroupMembers(
+	ctx context.Context,
+	clientV2 *edgecloudV2.Client,
+	instanceIDs []string,
+	createErr error,
+) ([]*instanceGroupMember, error) {
+	if len(instanceIDs) == 0 {
+		return nil, createErr
+	}
+
+	members := make([]*instanceGroupMember, 0, len(instanceIDs))
+	for _, instanceID := range instanceIDs {
+		members = append(members, &instanceGroupMember{id: instanceID, poolMemberIDs: make(map[string]string)})
+	}
+	log.Printf("[WARN] Deleting instances %v created by the failed request", instanceIDs)
+	if err := deleteInstanceGroupMembers(ctx, clientV2, members, InstanceDeleteTimeout); err != nil {
+		return members, fmt.Errorf("%w; the instances it created cannot be deleted and are kept as members: %w", createErr, err)
+	}
+
+	return nil, createErr
+}
+
+// deleteInstanceGroupMembers deletes the members with their volumes. All deletions are requested
+// before waiting for the tasks, so the members are deleted in parallel.
+func deleteInstanceGroupMembers(ctx context.Context, clientV2 *edgecloudV2.Client, members []*instanceGroupMember, timeout time.Duration) error {
+	tasks := make(map[string]string, len(members))
+	for _, member := range members {
+		volumes, _, err := clientV2.Volumes.List(ctx, &edgecloudV2.VolumeListOptions{InstanceID: member.id})
+		if err != nil {
+			return err
+		}
+		delOpts := edgecloudV2.InstanceDeleteOptions{}
+		for _, volume := range volumes {
+			delOpts.Volumes = append(delOpts.Volumes, volume.ID)
+		}
+
+		results, resp, err := clientV2.Instances.Delete(ctx, member.id, &delOpts)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("cannot delete instance %s: %w", member.id, err)
+		}
+		tasks[member.id] = results.Tasks[0]
+	}
+
+	for instanceID, taskID := range tasks {
+		log.Printf("[DEBUG] Task id (%s)", taskID)
+		task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, timeout)
+		if err != nil {
+			return err
+		}
+		if task.State == edgecloudV2.TaskStateError {
+			return fmt.Errorf("cannot delete instance with ID: %s", instanceID)
+		}
+	}
+
+	return nil
+}
+
+func resizeInstanceGroupMember(ctx context.Context, clientV2 *edgecloudV2.Client, instanceID, flavorID string, timeout time.Duration) error {
+	result, _, err := clientV2.Instances.UpdateFlavor(ctx, instanceID, &edgecloudV2.InstanceFlavorUpdateRequest{FlavorID: flavorID})
+	if err != nil {
+		return err
+	}
+	taskID := result.Tasks[0]
+	log.Printf("[DEBUG] Task id (%s)", taskID)
+	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, timeout)
+	if err != nil {
+		return err
+	}
+	if task.State == edgecloudV2.TaskStateError {
+		return fmt.Errorf("cannot update flavor in instance with ID: %s", instanceID)
+	}
+
+	return nil
+}
+
+// registerInstanceGroupMembers adds the members to every pool with one pool update per pool
+// and stores the IDs of the new pool members in the members.
+func registerInstanceGroupMembers(ctx context.Context, clientV2 *edgecloudV2.Client, pools []instanceGroupPool, members []*instanceGroupMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	for _, p := range pools {
+		newMembers := make([]edgecloudV2.PoolMemberCreateRequest, 0, len(members))
+		for _, member := range members {
+			address, err := member.address(p.subnetID)
+			if err != nil {
+				return err
+			}
+			newMembers = append(newMembers, edgecloudV2.PoolMemberCreateRequest{
+				Address:      net.ParseIP(address),
+				ProtocolPort: p.protocolPort,
+				Weight:       p.weight,
+				SubnetID:     p.subnetID,
+				InstanceID:   member.id,
+			})
+		}
+
+		pool, err := updateInstanceGroupPool(ctx, clientV2, p.poolID, nil, newMembers)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			for _, pm := range pool.Members {
+				if pm.InstanceID == member.id && pm.ProtocolPort == p.protocolPort {
+					member.poolMemberIDs[p.poolID] = pm.ID
+					break
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// deregisterInstanceGroupMembers removes the members from every pool with one pool update per pool.
+func deregisterInstanceGroupMembers(ctx context.Context, clientV2 *edgecloudV2.Client, pools []instanceGroupPool, members []*instanceGroupMember) error {
+	for _, p := range pools {
+		removed := make(map[string]struct{}, len(members))
+		for _, member := range members {
+			if memberID, ok := member.poolMemberIDs[p.poolID]; ok {
+				removed[memberID] = struct{}{}
+			}
+		}
+		if len(removed) == 0 {
+			continue
+		}
+
+		if _, err := updateInstanceGroupPool(ctx, clientV2, p.poolID, removed, nil); err != nil {
+			return err
+		}
+		for _, member := range members {
+			delete(member.poolMemberIDs, p.poolID)
+		}
+	}
+
+	return nil
+}
+
+// updateInstanceGroupPool replaces the members of the pool: it keeps the current members except the removed ones
+// and adds the new ones. It returns the pool after the update. A pool that no longer exists is ignored when
+// nothing is added to it.
+func updateInstanceGroupPool(
+	ctx context.Context,
+	clientV2 *edgecloudV2.Client,
+	poolID string,
+	removed map[string]struct{},
+	added []edgecloudV2.PoolMemberCreateRequest,
+) (*edgecloudV2.Pool, error) {
+	pool, resp, err := clientV2.Loadbalancers.PoolGet(ctx, poolID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound && len(added) == 0 {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot get pool %s: %w", poolID, err)
+	}
+
+	members := make([]edgecloudV2.PoolMemberCreateRequest, 0, len(pool.Members)+len(added))
+	for _, pm := range pool.Members {
+		if _, ok := removed[pm.ID]; ok {
+			continue
+		}
+		members = append(members, poolMemberRequest(pm))
+	}
+	members = append(members, added...)
+
+	if len(members) == 0 {
+		err = clearPoolMembers(ctx, clientV2, pool)
+	} else {
+		err = updatePoolMembers(ctx, clientV2, pool, members)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot update pool %s: %w", poolID, err)
+	}
+
+	pool, _, err = clientV2.Loadbalancers.PoolGet(ctx, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get pool %s: %w", poolID, err)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,67 @@
+package edgecenter
+
+import "testing"
+
+func TestInstanceGroupMemberAddress(t *testing.T) {
+	member := &instanceGroupMember{
+		id: "instance",
+		ips: []instanceGroupIP{
+			{subnetID: "first", address: "192.168.0.10"},
+			{subnetID: "second", address: "10.0.0.10"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		subnetID string
+		want     string
+		wantErr  bool
+	}{
+		{"first address by default", "", "192.168.0.10", false},
+		{"address in subnet", "second", "10.0.0.10", false},
+		{"no address in subnet", "third", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := member.address(tt.subnetID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("address error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("address = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := (&instanceGroupMember{id: "empty"}).address(""); err == nil {
+		t.Error("expected an error for a member without addresses")
+	}
+}
+
+func TestExpandInstanceGroupMembers(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			IDField:                         "instance",
+			NameField:                       "worker-192-168-0-10",
+			StatusField:                     "ACTIVE",
+			IPAddressField:                  "192.168.0.10",
+			InstanceGroupIPAddressesField:   []interface{}{"192.168.0.10", "10.0.0.10"},
+			InstanceGroupPoolMemberIDsField: map[string]interface{}{"pool": "member"},
+		},
+	}
+
+	members := expandInstanceGroupMembers(raw)
+	if len(members) != 1 {
+		t.Fatalf("got %d members, want 1", len(members))
+	}
+	member := members[0]
+	if member.id != "instance" || member.name != "worker-192-168-0-10" || member.status != "ACTIVE" {
+		t.Errorf("unexpected member %+v", member)
+	}
+	if len(member.ips) != 2 || member.ips[1].address != "10.0.0.10" {
+		t.Errorf("ips = %+v, want both addresses", member.ips)
+	}
+	if member.poolMemberIDs["pool"] != "member" {
+		t.Errorf("poolMemberIDs = %v, want the pool member", member.poolMemberIDs)
+	}
+}
@@ -0,0 +1,509 @@
+package edgecenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	// The time zones of the cron schedules are validated offline, also where the system has no time zone database.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	LifecyclePolicyNextRunsField = "next_runs"
+	LifecyclePolicyNextRunsCount = 5
+
+	// lifecyclePolicyCronHorizonYears bounds the search for the runs of a cron schedule. The weekdays
+	// of the calendar repeat every 28 years, so a schedule without a run within it never runs.
+	lifecyclePolicyCronHorizonYears = 28
+	// lifecyclePolicyCronGapRuns is the number of runs the shortest time between two runs of a cron schedule is taken from.
+	lifecyclePolicyCronGapRuns = 1000
+)
+
+var lifecyclePolicyWeekdays = map[string]time.Weekday{
+	"mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday, "thu": time.Thursday,
+	"fri": time.Friday, "sat": time.Saturday, "sun": time.Sunday,
+}
+
+// lifecyclePolicyTemplatePlaceholder matches a quoted placeholder of a resource name template, e.g. '{volume_id}'.
+var lifecyclePolicyTemplatePlaceholder = regexp.MustCompile(`\\\{\w+\\\}`)
+
+// lifecyclePolicyTargets holds the instances a lifecycle policy targets: the ones listed by ID and the ones
+// whose metadata contains all the key-value pairs of the selector.
+type lifecyclePolicyTargets struct {
+	instanceIDs []string
+	metadataKV  map[string]string
+}
+
+func expandLifecyclePolicyTargets(instanceIDs *schema.Set, selector []interface{}) (lifecyclePolicyTargets, error) {
+	var targets lifecyclePolicyTargets
+	for _, id := range instanceIDs.List() {
+		targets.instanceIDs = append(targets.instanceIDs, id.(string))
+	}
+	slices.Sort(targets.instanceIDs)
+
+	if len(selector) > 0 && selector[0] != nil {
+		kv, err := MapInterfaceToMapString(selector[0].(map[string]interface{})["metadata_kv"])
+		if err != nil {
+			return targets, err
+		}
+		targets.metadataKV = *kv
+	}
+
+	return targets, nil
+}
+
+func (t lifecyclePolicyTargets) empty() bool {
+	return len(t.instanceIDs) == 0 && len(t.metadataKV) == 0
+}
+
+// lifecyclePolicyCron is a parsed cron schedule. A run is at every minute that matches all the fields,
+// in the time zone of the schedule. A nil field matches any value.
+type lifecyclePolicyCron struct {
+	location *time.Location
+	months   []int
+	weeks    []int
+	days     []int
+	weekdays []time.Weekday
+	hours    []int
+	minutes  []int
+}
+
+func parseLifecyclePolicyCronField(value string) ([]int, error) {
+	if value == "*" {
+		return nil, nil
+	}
+
+	fields := splitByCommaOrSpace(value)
+	if len(fields) == 0 {
+		return nil, errors.New("empty value")
+	}
+	values := make([]int, 0, len(fields))
+	for _, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q", field)
+		}
+		values = append(values, n)
+	}
+
+	return values, nil
+}
+
+func expandLifecyclePolicyCron(flat map[string]interface{}) (*lifecyclePolicyCron, error) {
+	location, err := loadLifecyclePolicyTimezone(flat["timezone"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	cron := &lifecyclePolicyCron{location: location}
+	for _, field := range []struct {
+		name   string
+		values *[]int
+	}{
+		{"month", &cron.months},
+		{"week", &cron.weeks},
+		{"day", &cron.days},
+		{"hour", &cron.hours},
+		{"minute", &cron.minutes},
+	} {
+		if *field.values, err = parseLifecyclePolicyCronField(flat[field.name].(string)); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", field.name, err)
+		}
+	}
+
+	if dayOfWeek := flat["day_of_week"].(string); dayOfWeek != "*" {
+		for _, day := range splitByCommaOrSpace(dayOfWeek) {
+			weekday, ok := lifecyclePolicyWeekdays[strings.ToLower(day)]
+			if !ok {
+				return nil, fmt.Errorf("invalid day_of_week: invalid value %q", day)
+			}
+			cron.weekdays = append(cron.weekdays, weekday)
+		}
+		if len(cron.weekdays) == 0 {
+			return nil, errors.New("invalid day_of_week: empty value")
+		}
+	}
+
+	return cron, nil
+}
+
+func matchLifecyclePolicyCronField(values []int, value int) bool {
+	return values == nil || slices.Contains(values, value)
+}
+
+func (c *lifecyclePolicyCron) matchDate(date time.Time) bool {
+	_, week := date.ISOWeek()
+
+	return matchLifecyclePolicyCronField(c.months, int(date.Month())) &&
+		matchLifecyclePolicyCronField(c.weeks, week) &&
+		matchLifecyclePolicyCronField(c.days, date.Day()) &&
+		(c.weekdays == nil || slices.Contains(c.weekdays, date.Weekday()))
+}
+
+// nextRuns returns up to n runs after the given time. The times skipped when the clocks are set forward
+// have no run.
+func (c *lifecyclePolicyCron) nextRuns(from time.Time, n int) []time.Time {
+	from = from.In(c.location)
+	// Days are stepped at noon, which is never skipped by a clock change.
+	start := time.Date(from.Year(), from.Month(), from.Day(), 12, 0, 0, 0, c.location)
+	end := start.AddDate(lifecyclePolicyCronHorizonYears, 0, 0)
+
+	var runs []time.Time
+	for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+		if !c.matchDate(date) {
+			continue
+		}
+		year, month, day := date.Date()
+		for hour := 0; hour < 24; hour++ {
+			if !matchLifecyclePolicyCronField(c.hours, hour) {
+				continue
+			}
+			for minute := 0; minute < 60; minute++ {
+				if !matchLifecyclePolicyCronField(c.minutes, minute) {
+					continue
+				}
+				run := time.Date(year, month, day, hour, minute, 0, 0, c.location)
+				if run.Hour() != hour || run.Minute() != minute || !run.After(from) {
+					continue
+				}
+				runs = append(runs, run)
+				if len(runs) == n {
+					return runs
+				}
+			}
+		}
+	}
+
+	return runs
+}
+
+// loadLifecyclePolicyTimezone loads an IANA time zone. 'Local' is rejected, since it depends on where Terraform runs.
+func loadLifecyclePolicyTimezone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("invalid timezone %q: must be an IANA time zone name, e.g. 'Europe/Amsterdam'", name)
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: must be an IANA time zone name, e.g. 'Europe/Amsterdam'", name)
+	}
+
+	return location, nil
+}
+
+// lifecyclePolicyDuration returns the duration of an interval or a retention_time block.
+func lifecyclePolicyDuration(flat []interface{}) time.Duration {
+	if len(flat) == 0 || flat[0] == nil {
+		return 0
+	}
+	timer := flat[0].(map[string]interface{})
+
+	return time.Duration(timer["weeks"].(int))*7*24*time.Hour +
+		time.Duration(timer["days"].(int))*24*time.Hour +
+		time.Duration(timer["hours"].(int))*time.Hour +
+		time.Duration(timer["minutes"].(int))*time.Minute
+}
+
+func lifecyclePolicyScheduleCron(flat map[string]interface{}) (*lifecyclePolicyCron, error) {
+	cron := flat["cron"].([]interface{})
+	if len(cron) == 0 {
+		return nil, nil
+	}
+	if cron[0] == nil {
+		return nil, errors.New("empty cron block")
+	}
+
+	return expandLifecyclePolicyCron(cron[0].(map[string]interface{}))
+}
+
+// validateLifecyclePolicySchedule rejects a schedule that never runs and a schedule that deletes every
+// snapshot before it takes the next one.
+func validateLifecyclePolicySchedule(flat map[string]interface{}, now time.Time) error {
+	interval := flat["interval"].([]interface{})
+	if len(interval)+len(flat["cron"].([]interface{})) != 1 {
+		return errors.New("exactly one of interval and cron blocks should be provided")
+	}
+
+	var gap time.Duration
+	if len(interval) > 0 {
+		if gap = lifecyclePolicyDuration(interval); gap < time.Minute {
+			return errors.New("interval must be at least one minute")
+		}
+	} else {
+		cron, err := lifecyclePolicyScheduleCron(flat)
+		if err != nil {
+			return fmt.Errorf("cron: %w", err)
+		}
+		runs := cron.nextRuns(now, lifecyclePolicyCronGapRuns)
+		if len(runs) == 0 {
+			return errors.New("cron never runs: no date matches all of its fields")
+		}
+		for i := 1; i < len(runs); i++ {
+			if d := runs[i].Sub(runs[i-1]); gap == 0 || d < gap {
+				gap = d
+			}
+		}
+	}
+
+	retention := lifecyclePolicyDuration(flat["retention_time"].([]interface{}))
+	if retention > 0 && gap > 0 && retention < gap {
+		return fmt.Errorf("retention_time (%s) is shorter than the time between two runs (%s), "+
+			"so every snapshot is deleted before the next one is taken", retention, gap)
+	}
+
+	return nil
+}
+
+// lifecyclePolicyNextRuns returns the next runs of the cron schedules in RFC 3339 format, in the time zone
+// of each schedule. The runs of the interval schedules depend on when the service started them, so they
+// are not known in advance.
+func lifecyclePolicyNextRuns(schedules []interface{}, from time.Time) ([]string, error) {
+	var runs []time.Time
+	for _, raw := range schedules {
+		cron, err := lifecyclePolicyScheduleCron(raw.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		if cron != nil {
+			runs = append(runs, cron.nextRuns(from, LifecyclePolicyNextRunsCount)...)
+		}
+	}
+
+	slices.SortStableFunc(runs, func(a, b time.Time) int { return a.Compare(b) })
+	runs = slices.CompactFunc(runs, func(a, b time.Time) bool { return a.Equal(b) })
+	if len(runs) > LifecyclePolicyNextRunsCount {
+		runs = runs[:LifecyclePolicyNextRunsCount]
+	}
+
+	result := make([]string, 0, len(runs))
+	for _, run := range runs {
+		result = append(result, run.Format(time.RFC3339))
+	}
+
+	return result, nil
+}
+
+// setLifecyclePolicyNextRuns sets the next runs of the schedules in state. They are informational,
+// so a schedule the provider cannot parse only leaves them empty.
+func setLifecyclePolicyNextRuns(d *schema.ResourceData) {
+	runs, err := lifecyclePolicyNextRuns(d.Get("schedule").([]interface{}), time.Now())
+	if err != nil {
+		log.Printf("[WARN] Cannot compute the next runs of lifecycle policy %s: %s", d.Id(), err)
+	}
+	_ = d.Set(LifecyclePolicyNextRunsField, runs)
+}
+
+// lifecyclePolicyScheduleDiff validates the schedules at plan time and marks the next runs of new schedules as computed.
+func lifecyclePolicyScheduleDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if raw := d.GetRawConfig(); !raw.IsNull() && (!raw.IsKnown() || !raw.GetAttr("schedule").IsWhollyKnown()) {
+		return d.SetNewComputed(LifecyclePolicyNextRunsField)
+	}
+
+	now := time.Now()
+	schedules := d.Get("schedule").([]interface{})
+	for i, raw := range schedules {
+		if err := validateLifecyclePolicySchedule(raw.(map[string]interface{}), now); err != nil {
+			return fmt.Errorf("schedule.%d: %w", i, err)
+		}
+	}
+
+	if d.Id() != "" && !d.HasChange("schedule") {
+		return nil
+	}
+
+	// The runs are computed from the schedules the policy is read back with after the apply.
+	return d.SetNewComputed(LifecyclePolicyNextRunsField)
+}
+
+// resolveLifecyclePolicyInstanceVolumes returns the sorted IDs of all the volumes attached to the targeted
+// instances. The volumes of an instance are in the same policy, so every schedule run snapshots all of them.
+func resolveLifecyclePolicyInstanceVolumes(ctx context.Context, clientV2 *edgecloudV2.Client, targets lifecyclePolicyTargets) ([]string, error) {
+	instanceIDs := slices.Clone(targets.instanceIDs)
+	for _, id := range targets.instanceIDs {
+		if _, resp, err := clientV2.Instances.Get(ctx, id); err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, fmt.Errorf("instance %s does not exist", id)
+			}
+			return nil, fmt.Errorf("cannot get instance %s: %w", id, err)
+		}
+	}
+
+	if len(targets.metadataKV) > 0 {
+		instances, err := listAllPages(func(limit, offset int) ([]edgecloudV2.Instance, error) {
+			page, _, err := clientV2.Instances.List(ctx, &edgecloudV2.InstanceListOptions{Limit: limit, Offset: offset})
+			return page, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot list instances: %w", err)
+		}
+
+		filter := &listFilter{metadataKV: targets.metadataKV}
+		for i := range instances {
+			instance := &instances[i]
+			if filter.matchMetadata(instanceMetadataMap(instance)) && !slices.Contains(instanceIDs, instance.ID) {
+				instanceIDs = append(instanceIDs, instance.ID)
+			}
+		}
+	}
+
+	var volumeIDs []string
+	for _, instanceID := range instanceIDs {
+		volumes, _, err := clientV2.Volumes.List(ctx, &edgecloudV2.VolumeListOptions{InstanceID: instanceID})
+		if err != nil {
+			return nil, fmt.Errorf("cannot list the volumes of instance %s: %w", instanceID, err)
+		}
+		for _, volume := range volumes {
+			if !slices.Contains(volumeIDs, volume.ID) {
+				volumeIDs = append(volumeIDs, volume.ID)
+			}
+		}
+	}
+	slices.Sort(volumeIDs)
+
+	return volumeIDs, nil
+}
+
+// lifecyclePolicyInstanceVolumes resolves the volumes of the targeted instances at apply time.
+func lifecyclePolicyInstanceVolumes(ctx context.Context, clientV2 *edgecloudV2.Client, d *schema.ResourceData) ([]string, error) {
+	targets, err := expandLifecyclePolicyTargets(d.Get("instance_ids").(*schema.Set), d.Get("instance_selector").([]interface{}))
+	if err != nil || targets.empty() {
+		return nil, err
+	}
+
+	return resolveLifecyclePolicyInstanceVolumes(ctx, clientV2, targets)
+}
+
+// lifecyclePolicyInstancesDiff plans the volumes of the targeted instances, so that instances that start
+// matching the selector and volumes attached to the targeted instances show up in the plan.
+func lifecyclePolicyInstancesDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("instance_ids") || !d.NewValueKnown("instance_selector") {
+		return d.SetNewComputed("instance_volume_ids")
+	}
+
+	targets, err := expandLifecyclePolicyTargets(d.Get("instance_ids").(*schema.Set), d.Get("instance_selector").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if targets.empty() {
+		if d.Get("instance_volume_ids").(*schema.Set).Len() > 0 {
+			return d.SetNew("instance_volume_ids", []interface{}{})
+		}
+		return nil
+	}
+
+	config, ok := m.(*Config)
+	if !ok {
+		return nil
+	}
+	clientV2, ok, err := planCloudClient(d, config)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return d.SetNewComputed("instance_volume_ids")
+	}
+
+	volumeIDs, err := resolveLifecyclePolicyInstanceVolumes(ctx, clientV2, targets)
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("instance_volume_ids", stringsToInterfaces(volumeIDs))
+}
+
+// lifecyclePolicyVolumeIDs returns the IDs of all the volumes of the policy: the listed volumes
+// and the volumes of the targeted instances.
+func lifecyclePolicyVolumeIDs(volumes *schema.Set, instanceVolumeIDs []string) []string {
+	ids := slices.Clone(instanceVolumeIDs)
+	for _, id := range expandVolumeIds(volumes.List()) {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	return ids
+}
+
+// splitLifecyclePolicyVolumes splits the volumes of the policy into the listed volumes and the volumes
+// of the targeted instances, following the previous state. Volumes added to the policy outside of
+// Terraform are reported as listed volumes, so the plan removes them.
+func splitLifecyclePolicyVolumes(
+	volumes []edgecloudV2.LifeCyclePolicyVolume, listed *schema.Set, instanceVolumeIDs *schema.Set,
+) ([]edgecloudV2.LifeCyclePolicyVolume, []string) {
+	listedIDs := expandVolumeIds(listed.List())
+
+	var own []edgecloudV2.LifeCyclePolicyVolume
+	fromInstances := make([]string, 0, len(volumes))
+	for _, volume := range volumes {
+		fromInstance := instanceVolumeIDs.Contains(volume.ID)
+		if fromInstance {
+			fromInstances = append(fromInstances, volume.ID)
+		}
+		if !fromInstance || slices.Contains(listedIDs, volume.ID) {
+			own = append(own, volume)
+		}
+	}
+
+	return own, fromInstances
+}
+
+// lifecyclePolicySnapshotMatches reports whether a snapshot of the volume was named by one of the resource
+// name templates of the policy schedules. '{volume_id}' is rendered, other placeholders match any text.
+func lifecyclePolicySnapshotMatches(templates []string, volumeID, name string) bool {
+	for _, template := range templates {
+		pattern := strings.ReplaceAll(regexp.QuoteMeta(template), regexp.QuoteMeta("{volume_id}"), regexp.QuoteMeta(volumeID))
+		pattern = lifecyclePolicyTemplatePlaceholder.ReplaceAllString(pattern, ".*")
+		if regexp.MustCompile("^" + pattern).MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stringSymmetricDifference returns the strings only in the old list and the strings only in the new one.
+func stringSymmetricDifference(oldValues, newValues []string) ([]string, []string) {
+	toRemove := make([]string, 0)
+	for _, v := range oldValues {
+		if !slices.Contains(newValues, v) {
+			toRemove = append(toRemove, v)
+		}
+	}
+	toAdd := make([]string, 0)
+	for _, v := range newValues {
+		if !slices.Contains(oldValues, v) {
+			toAdd = append(toAdd, v)
+		}
+	}
+
+	return toRemove, toAdd
+}
+
+func expandStringSet(set *schema.Set) []string {
+	result := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		result = append(result, v.(string))
+	}
+
+	return result
+}
+
+func stringsToInterfaces(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+
+	return result
+}
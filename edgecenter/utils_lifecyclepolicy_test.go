@@ -0,0 +1,205 @@
+package edgecenter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func testLifecyclePolicyVolumeSet(ids ...string) *schema.Set {
+	elem := resourceLifecyclePolicy().Schema["volume"].Elem.(*schema.Resource)
+	items := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, map[string]interface{}{"id": id, "name": ""})
+	}
+
+	return schema.NewSet(schema.HashResource(elem), items)
+}
+
+func TestLifecyclePolicyVolumeIDs(t *testing.T) {
+	got := lifecyclePolicyVolumeIDs(testLifecyclePolicyVolumeSet("vol-c", "vol-a"), []string{"vol-b", "vol-a"})
+	if want := []string{"vol-a", "vol-b", "vol-c"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSplitLifecyclePolicyVolumes(t *testing.T) {
+	volumes := []edgecloudV2.LifeCyclePolicyVolume{{ID: "vol-listed"}, {ID: "vol-both"}, {ID: "vol-instance"}, {ID: "vol-outside"}}
+	listed := testLifecyclePolicyVolumeSet("vol-listed", "vol-both")
+	instanceVolumeIDs := schema.NewSet(schema.HashString, []interface{}{"vol-both", "vol-instance"})
+
+	own, fromInstances := splitLifecyclePolicyVolumes(volumes, listed, instanceVolumeIDs)
+
+	ownIDs := make([]string, 0, len(own))
+	for _, volume := range own {
+		ownIDs = append(ownIDs, volume.ID)
+	}
+	if want := []string{"vol-listed", "vol-both", "vol-outside"}; !slices.Equal(ownIDs, want) {
+		t.Errorf("listed volumes = %v, want %v", ownIDs, want)
+	}
+	if want := []string{"vol-both", "vol-instance"}; !slices.Equal(fromInstances, want) {
+		t.Errorf("instance volumes = %v, want %v", fromInstances, want)
+	}
+}
+
+func TestStringSymmetricDifference(t *testing.T) {
+	toRemove, toAdd := stringSymmetricDifference([]string{"a", "b"}, []string{"b", "c"})
+	if !slices.Equal(toRemove, []string{"a"}) || !slices.Equal(toAdd, []string{"c"}) {
+		t.Errorf("got %v to remove and %v to add, want [a] and [c]", toRemove, toAdd)
+	}
+}
+
+func TestLifecyclePolicySnapshotMatches(t *testing.T) {
+	templates := []string{"reserve snap of the volume {volume_id}", "daily.{volume_id}.{date}"}
+
+	tests := []struct {
+		name     string
+		volumeID string
+		snapshot string
+		want     bool
+	}{
+		{"default template", "vol-1", "reserve snap of the volume vol-1", true},
+		{"suffix added by the schedule", "vol-1", "reserve snap of the volume vol-1 2026-01-01", true},
+		{"other placeholders", "vol-1", "daily.vol-1.2026-01-01", true},
+		{"another volume", "vol-1", "reserve snap of the volume vol-2", false},
+		{"dot is literal", "vol-1", "dailyXvol-1.2026-01-01", false},
+		{"manual snapshot", "vol-1", "before upgrade", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lifecyclePolicySnapshotMatches(templates, tt.volumeID, tt.snapshot); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+
+	if lifecyclePolicySnapshotMatches(nil, "vol-1", "reserve snap of the volume vol-1") {
+		t.Error("a policy without schedules must not match any snapshot")
+	}
+}
+
+func testLifecyclePolicyCronSchedule(timezone, dayOfWeek, day, hour, minute string, retention []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"cron": []interface{}{map[string]interface{}{
+			"timezone": timezone, "month": "*", "week": "*", "day": day, "day_of_week": dayOfWeek, "hour": hour, "minute": minute,
+		}},
+		"interval":       []interface{}{},
+		"retention_time": retention,
+	}
+}
+
+func testLifecyclePolicyTimer(weeks, days, hours, minutes int) []interface{} {
+	return []interface{}{map[string]interface{}{"weeks": weeks, "days": days, "hours": hours, "minutes": minutes}}
+}
+
+func TestLifecyclePolicyNextRuns(t *testing.T) {
+	now := time.Date(2026, 3, 27, 10, 0, 0, 0, time.UTC)
+
+	// 02:30 does not exist in Amsterdam on 2026-03-29, when the clocks are set forward.
+	runs, err := lifecyclePolicyNextRuns([]interface{}{
+		testLifecyclePolicyCronSchedule("Europe/Amsterdam", "*", "*", "2", "30", []interface{}{}),
+	}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"2026-03-28T02:30:00+01:00", "2026-03-30T02:30:00+02:00", "2026-03-31T02:30:00+02:00",
+		"2026-04-01T02:30:00+02:00", "2026-04-02T02:30:00+02:00",
+	}
+	if !slices.Equal(runs, want) {
+		t.Errorf("got %v, want %v", runs, want)
+	}
+
+	runs, err = lifecyclePolicyNextRuns([]interface{}{
+		testLifecyclePolicyCronSchedule("UTC", "mon,fri", "*", "0", "0", []interface{}{}),
+		testLifecyclePolicyCronSchedule("Asia/Tokyo", "*", "1", "9", "0", []interface{}{}),
+		map[string]interface{}{"cron": []interface{}{}, "interval": testLifecyclePolicyTimer(0, 0, 1, 0), "retention_time": []interface{}{}},
+	}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{
+		"2026-03-30T00:00:00Z", "2026-04-01T09:00:00+09:00", "2026-04-03T00:00:00Z",
+		"2026-04-06T00:00:00Z", "2026-04-10T00:00:00Z",
+	}
+	if !slices.Equal(runs, want) {
+		t.Errorf("got %v, want %v", runs, want)
+	}
+}
+
+func TestValidateLifecyclePolicySchedule(t *testing.T) {
+	now := time.Date(2026, 3, 27, 10, 0, 0, 0, time.UTC)
+	interval := func(interval, retention []interface{}) map[string]interface{} {
+		return map[string]interface{}{"cron": []interface{}{}, "interval": interval, "retention_time": retention}
+	}
+
+	tests := []struct {
+		name     string
+		schedule map[string]interface{}
+		wantErr  string
+	}{
+		{"interval without retention", interval(testLifecyclePolicyTimer(0, 0, 1, 0), []interface{}{}), ""},
+		{"retention as long as the interval", interval(testLifecyclePolicyTimer(1, 0, 0, 0), testLifecyclePolicyTimer(0, 7, 0, 0)), ""},
+		{"retention shorter than the interval", interval(testLifecyclePolicyTimer(1, 0, 0, 0), testLifecyclePolicyTimer(0, 6, 0, 0)), "shorter than the time between two runs"},
+		{"empty interval", interval(testLifecyclePolicyTimer(0, 0, 0, 0), []interface{}{}), "at least one minute"},
+		{"cron retention covers the longest gap", testLifecyclePolicyCronSchedule("UTC", "mon,fri", "*", "0", "0", testLifecyclePolicyTimer(0, 4, 0, 0)), ""},
+		{"cron retention covers the shortest gap", testLifecyclePolicyCronSchedule("UTC", "mon,fri", "*", "0", "0", testLifecyclePolicyTimer(0, 3, 0, 0)), ""},
+		{"cron retention shorter than every gap", testLifecyclePolicyCronSchedule("UTC", "mon,fri", "*", "0", "0", testLifecyclePolicyTimer(0, 2, 0, 0)), "shorter than the time between two runs"},
+		{"cron on the 29th on a monday", testLifecyclePolicyCronSchedule("UTC", "mon", "29", "0", "0", []interface{}{}), ""},
+		{"cron on the 31st", testLifecyclePolicyCronSchedule("UTC", "*", "31", "0", "0", []interface{}{}), ""},
+		{"cron with an invalid timezone", testLifecyclePolicyCronSchedule("Mars/Base", "*", "*", "0", "0", []interface{}{}), "invalid timezone"},
+		{
+			"both interval and cron",
+			map[string]interface{}{
+				"cron":           testLifecyclePolicyCronSchedule("UTC", "*", "*", "0", "0", nil)["cron"],
+				"interval":       testLifecyclePolicyTimer(0, 0, 1, 0),
+				"retention_time": []interface{}{},
+			},
+			"exactly one of interval and cron",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLifecyclePolicySchedule(tt.schedule, now)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("got %v, want no error", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Fatalf("got %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	never := testLifecyclePolicyCronSchedule("UTC", "*", "30", "0", "0", []interface{}{})
+	never["cron"].([]interface{})[0].(map[string]interface{})["month"] = "2"
+	if err := validateLifecyclePolicySchedule(never, now); err == nil || !strings.Contains(err.Error(), "never runs") {
+		t.Errorf("got %v for february 30th, want an error containing %q", err, "never runs")
+	}
+}
+
+func TestValidateTimezone(t *testing.T) {
+	for _, timezone := range []string{"UTC", "Europe/Amsterdam", "America/Argentina/Buenos_Aires"} {
+		if _, errs := validateTimezone(timezone, "timezone"); len(errs) != 0 {
+			t.Errorf("%q: got %v, want no error", timezone, errs)
+		}
+	}
+	for _, timezone := range []string{"", "Local", "Europe/Amsterdm", "UTC+3"} {
+		if _, errs := validateTimezone(timezone, "timezone"); len(errs) == 0 {
+			t.Errorf("%q: got no error, want one", timezone)
+		}
+	}
+}
+
+func TestValidateCronFieldEmpty(t *testing.T) {
+	if _, errs := validateCronField(0, 23)(" , ", "hour"); len(errs) == 0 {
+		t.Error("got no error for an empty hour, want one")
+	}
+	if _, errs := validateDaysOfWeek("", "day_of_week"); len(errs) == 0 {
+		t.Error("got no error for an empty day_of_week, want one")
+	}
+}
@@ -73,44 +73,74 @@ func extractHealthMonitorMapV2(d *schema.ResourceData) *edgecloudV2.HealthMonito
 	var healthOpts *edgecloudV2.HealthMonitorCreateRequest
 	monitors := d.Get("health_monitor").([]interface{})
 	if len(monitors) > 0 {
-		hm := monitors[0].(map[string]interface{})
-		healthOpts = &edgecloudV2.HealthMonitorCreateRequest{
-			Type:       edgecloudV2.HealthMonitorType(hm["type"].(string)),
-			Delay:      hm["delay"].(int),
-			MaxRetries: hm["max_retries"].(int),
-			Timeout:    hm["timeout"].(int),
-		}
+		healthOpts = expandHealthMonitorV2(monitors[0].(map[string]interface{}))
+	}
 
-		maxRetriesDown := hm["max_retries_down"].(int)
-		if maxRetriesDown != 0 {
-			healthOpts.MaxRetriesDown = maxRetriesDown
-		}
+	return healthOpts
+}
 
-		httpMethod := hm["http_method"].(string)
-		if httpMethod != "" {
-			hm := edgecloudV2.HTTPMethod(httpMethod)
-			healthOpts.HTTPMethod = &hm
-		}
+// expandHealthMonitorV2 creates a health monitor options struct from a health monitor map.
+// The optional fields left empty are not sent, so the API applies its defaults.
+func expandHealthMonitorV2(hm map[string]interface{}) *edgecloudV2.HealthMonitorCreateRequest {
+	healthOpts := &edgecloudV2.HealthMonitorCreateRequest{
+		Type:       edgecloudV2.HealthMonitorType(hm["type"].(string)),
+		Delay:      hm["delay"].(int),
+		MaxRetries: hm["max_retries"].(int),
+		Timeout:    hm["timeout"].(int),
+	}
 
-		urlPath := hm["url_path"].(string)
-		if urlPath != "" {
-			healthOpts.URLPath = urlPath
-		}
+	maxRetriesDown := hm["max_retries_down"].(int)
+	if maxRetriesDown != 0 {
+		healthOpts.MaxRetriesDown = maxRetriesDown
+	}
 
-		expectedCodes := hm["expected_codes"].(string)
-		if expectedCodes != "" {
-			healthOpts.ExpectedCodes = expectedCodes
-		}
+	httpMethod := hm["http_method"].(string)
+	if httpMethod != "" {
+		hm := edgecloudV2.HTTPMethod(httpMethod)
+		healthOpts.HTTPMethod = &hm
+	}
 
-		id := hm["id"].(string)
-		if id != "" {
-			healthOpts.ID = id
-		}
+	urlPath := hm["url_path"].(string)
+	if urlPath != "" {
+		healthOpts.URLPath = urlPath
+	}
+
+	expectedCodes := hm["expected_codes"].(string)
+	if expectedCodes != "" {
+		healthOpts.ExpectedCodes = expectedCodes
+	}
+
+	id, _ := hm["id"].(string)
+	if id != "" {
+		healthOpts.ID = id
 	}
 
 	return healthOpts
 }
 
+// flattenHealthMonitorV2 converts the health monitor of a pool into a map, it returns nil when the pool has no monitor.
+func flattenHealthMonitorV2(pool *edgecloudV2.Pool) map[string]interface{} {
+	if pool.HealthMonitor == nil {
+		return nil
+	}
+
+	healthMonitor := map[string]interface{}{
+		"id":               pool.HealthMonitor.ID,
+		"type":             pool.HealthMonitor.Type,
+		"delay":            pool.HealthMonitor.Delay,
+		"timeout":          pool.HealthMonitor.Timeout,
+		"max_retries":      pool.HealthMonitor.MaxRetries,
+		"max_retries_down": pool.HealthMonitor.MaxRetriesDown,
+		"url_path":         pool.HealthMonitor.URLPath,
+		"expected_codes":   pool.HealthMonitor.ExpectedCodes,
+	}
+	if pool.HealthMonitor.HTTPMethod != nil {
+		healthMonitor["http_method"] = pool.HealthMonitor.HTTPMethod
+	}
+
+	return healthMonitor
+}
+
 // extractListenerIntoMapV2 converts a listener object into a map.
 func extractListenerIntoMapV2(listener *edgecloudV2.Listener) map[string]interface{} {
 	l := make(map[string]interface{})
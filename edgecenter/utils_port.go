@@ -0,0 +1,85 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+const portReservationResourceTypeInstance = "instance"
+
+// portReservedFixedIPType returns the type of the reserved fixed IP that backs a port in the
+// network or the subnet, with the fixed IP address if any.
+func portReservedFixedIPType(networkID, subnetID, fixedIPAddress string) (edgecloudV2.ReservedFixedIPType, error) {
+	switch {
+	case subnetID != "":
+		return edgecloudV2.ReservedFixedIPTypeSubnet, nil
+	case networkID != "" && fixedIPAddress != "":
+		return edgecloudV2.ReservedFixedIPTypeIPAddress, nil
+	case networkID != "":
+		return edgecloudV2.ReservedFixedIPTypeAnySubnet, nil
+	}
+
+	return "", fmt.Errorf("either 'network_id' or 'subnet_id' must be set")
+}
+
+// portInstanceID returns the ID of the instance the port is attached to, or an empty string.
+func portInstanceID(port *edgecloudV2.ReservedFixedIP) string {
+	if port.Reservation.ResourceType != portReservationResourceTypeInstance {
+		return ""
+	}
+
+	return port.Reservation.ResourceID
+}
+
+// flattenAllowedAddressPairs returns the allowed address pairs of the port in the form of the
+// allowed_address_pairs items.
+func flattenAllowedAddressPairs(port *edgecloudV2.ReservedFixedIP) []map[string]interface{} {
+	allowedPairs := make([]map[string]interface{}, len(port.AllowedAddressPairs))
+	for i, p := range port.AllowedAddressPairs {
+		allowedPairs[i] = map[string]interface{}{
+			"ip_address":  p.IPAddress,
+			"mac_address": p.MacAddress,
+		}
+	}
+
+	return allowedPairs
+}
+
+// portInstanceSecurity returns whether the port security is enabled on the port of the instance
+// and the IDs of the security groups of the port.
+func portInstanceSecurity(ctx context.Context, client *edgecloudV2.Client, instanceID, portID string) (bool, []interface{}, error) {
+	iface, err := utilV2.InstanceNetworkInterfaceByID(ctx, client, instanceID, portID)
+	if err != nil {
+		return false, nil, err
+	}
+
+	port, err := utilV2.InstanceNetworkPortByID(ctx, client, instanceID, portID)
+	if err != nil {
+		return false, nil, err
+	}
+
+	sgIDs := make([]interface{}, len(port.SecurityGroups))
+	for i, sg := range port.SecurityGroups {
+		sgIDs[i] = sg.ID
+	}
+
+	return iface.PortSecurityEnabled, sgIDs, nil
+}
+
+// updatePortSecurityGroups assigns the added and unassigns the removed security groups of the
+// port of the instance.
+func updatePortSecurityGroups(ctx context.Context, client *edgecloudV2.Client, instanceID, portID string, oldSGs, newSGs *schema.Set) error {
+	if err := removeSecurityGroupsFromInstancePort(ctx, client, instanceID, portID, oldSGs.Difference(newSGs).List()); err != nil {
+		return fmt.Errorf("cannot unassign security groups from port %s: %w", portID, err)
+	}
+	if err := AssignSecurityGroupsToInstancePort(ctx, client, instanceID, portID, newSGs.Difference(oldSGs).List()); err != nil {
+		return fmt.Errorf("cannot assign security groups to port %s: %w", portID, err)
+	}
+
+	return nil
+}
@@ -90,6 +90,96 @@ func routerInterfaceUniqueID(i interface{}) int {
 	return int(binary.BigEndian.Uint64(h.Sum(nil)))
 }
 
+// flattenRouterInterfaces returns the subnet interfaces of the router in the form of the interfaces items.
+func flattenRouterInterfaces(router *edgecloudV2.Router) []interface{} {
+	ifs := make([]interface{}, 0, len(router.Interfaces))
+	for _, iface := range router.Interfaces {
+		for _, subnet := range iface.IPAssignments {
+			smap := make(map[string]interface{}, 6)
+			smap["port_id"] = iface.PortID
+			smap["network_id"] = iface.NetworkID
+			smap["mac_address"] = iface.MacAddress
+			smap["type"] = "subnet"
+			smap["subnet_id"] = subnet.SubnetID
+			smap["ip_address"] = subnet.IPAddress.String()
+			ifs = append(ifs, smap)
+		}
+	}
+
+	return ifs
+}
+
+// managedRouterInterfaces returns the interfaces whose subnet is one of the known interfaces.
+func managedRouterInterfaces(known *schema.Set, ifs []interface{}) []interface{} {
+	subnets := make(map[string]struct{}, known.Len())
+	for _, k := range known.List() {
+		subnets[k.(map[string]interface{})["subnet_id"].(string)] = struct{}{}
+	}
+
+	managed := make([]interface{}, 0, len(subnets))
+	for _, iface := range ifs {
+		if _, ok := subnets[iface.(map[string]interface{})["subnet_id"].(string)]; ok {
+			managed = append(managed, iface)
+		}
+	}
+
+	return managed
+}
+
+// routerRouteKey identifies a static route of a router by its destination and next hop.
+func routerRouteKey(destination, nexthop string) string {
+	return destination + "-" + nexthop
+}
+
+// managedRouterRoutes returns the routes that are among the known routes.
+func managedRouterRoutes(known *schema.Set, rs []interface{}) []interface{} {
+	keys := make(map[string]struct{}, known.Len())
+	for _, k := range known.List() {
+		r := k.(map[string]interface{})
+		keys[routerRouteKey(r["destination"].(string), r["nexthop"].(string))] = struct{}{}
+	}
+
+	managed := make([]interface{}, 0, len(keys))
+	for _, route := range rs {
+		r := route.(map[string]interface{})
+		if _, ok := keys[routerRouteKey(r["destination"].(string), r["nexthop"].(string))]; ok {
+			managed = append(managed, route)
+		}
+	}
+
+	return managed
+}
+
+// mergeRouterRoutes returns the routes without the removed ones, followed by the added ones
+// that are not among the routes yet.
+func mergeRouterRoutes(routes, removed, added []edgecloudV2.HostRoute) []edgecloudV2.HostRoute {
+	removedKeys := make(map[string]struct{}, len(removed))
+	for _, r := range removed {
+		removedKeys[routerRouteKey(r.Destination.String(), r.NextHop.String())] = struct{}{}
+	}
+
+	merged := make([]edgecloudV2.HostRoute, 0, len(routes)+len(added))
+	present := make(map[string]struct{}, len(routes)+len(added))
+	for _, r := range routes {
+		key := routerRouteKey(r.Destination.String(), r.NextHop.String())
+		if _, ok := removedKeys[key]; ok {
+			continue
+		}
+		present[key] = struct{}{}
+		merged = append(merged, r)
+	}
+	for _, r := range added {
+		key := routerRouteKey(r.Destination.String(), r.NextHop.String())
+		if _, ok := present[key]; ok {
+			continue
+		}
+		present[key] = struct{}{}
+		merged = append(merged, r)
+	}
+
+	return merged
+}
+
 // extractExternalGatewayInfoMap converts the first element of a gateway slice
 // into a routers.GatewayInfo struct using the provided mapstructure decoder configuration.
 func extractExternalGatewayInfoMap(gw []interface{}) (routers.GatewayInfo, error) {
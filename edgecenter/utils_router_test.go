@@ -0,0 +1,74 @@
+package edgecenter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func routerRouteItem(destination, nexthop string) map[string]interface{} {
+	return map[string]interface{}{
+		"destination": destination,
+		"nexthop":     nexthop,
+	}
+}
+
+func hostRoutes(t *testing.T, items ...map[string]interface{}) []edgecloudV2.HostRoute {
+	t.Helper()
+
+	v := make([]interface{}, len(items))
+	for i, item := range items {
+		v[i] = item
+	}
+	routes, err := extractHostRoutesMapV2(v)
+	if err != nil {
+		t.Fatalf("extractHostRoutesMapV2: %v", err)
+	}
+
+	return routes
+}
+
+func TestManagedRouterRoutes(t *testing.T) {
+	known := schema.NewSet(schema.HashResource(HostRouteSchema(true)), []interface{}{
+		routerRouteItem("10.0.1.0/24", "192.168.0.1"),
+	})
+
+	rs := []interface{}{
+		routerRouteItem("10.0.1.0/24", "192.168.0.1"),
+		routerRouteItem("10.0.2.0/24", "192.168.0.2"),
+	}
+
+	got := managedRouterRoutes(known, rs)
+	if len(got) != 1 || got[0].(map[string]interface{})["destination"] != "10.0.1.0/24" {
+		t.Errorf("managed routes = %v, want the route to 10.0.1.0/24", got)
+	}
+}
+
+func TestMergeRouterRoutes(t *testing.T) {
+	current := hostRoutes(t,
+		routerRouteItem("10.0.1.0/24", "192.168.0.1"),
+		routerRouteItem("10.0.2.0/24", "192.168.0.2"),
+	)
+	removed := hostRoutes(t, routerRouteItem("10.0.1.0/24", "192.168.0.1"))
+	added := hostRoutes(t,
+		routerRouteItem("10.0.2.0/24", "192.168.0.2"),
+		routerRouteItem("10.0.3.0/24", "192.168.0.3"),
+	)
+
+	got := mergeRouterRoutes(current, removed, added)
+
+	want := []string{
+		routerRouteKey("10.0.2.0/24", "192.168.0.2"),
+		routerRouteKey("10.0.3.0/24", "192.168.0.3"),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("merged %d routes, want %d", len(got), len(want))
+	}
+	for i, r := range got {
+		if key := routerRouteKey(r.Destination.String(), r.NextHop.String()); key != want[i] {
+			t.Errorf("route %d = %s, want %s", i, key, want[i])
+		}
+	}
+}
@@ -3,14 +3,43 @@ package edgecenter
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"log"
+	"slices"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
 )
 
+const (
+	SecretNotAfterField                = "not_after"
+	SecretSubjectAlternativeNamesField = "subject_alternative_names"
+	SecretIssuerField                  = "issuer"
+	SecretFingerprintField             = "fingerprint"
+	SecretExpirationWarningDaysField   = "expiration_warning_days"
+
+	SecretExpirationWarningDaysDefault = 30
+)
+
+// secretRotationFields are the fields the secret is created from. The API cannot change a secret,
+// so a change of any of them rotates it.
+var secretRotationFields = []string{"name", "private_key", "certificate_chain", "certificate", "expiration"}
+
+// secretCertificateMetadataFields are the fields parsed locally from the certificate.
+var secretCertificateMetadataFields = []string{
+	SecretNotAfterField, SecretSubjectAlternativeNamesField, SecretIssuerField, SecretFingerprintField,
+}
+
 // getSecret retrieves a secret from the edge cloud service.
 // It attempts to find the secret either by its ID or by its name.
 func getSecret(ctx context.Context, clientV2 *edgecloudV2.Client, d *schema.ResourceData) (*edgecloudV2.Secret, error) {
@@ -60,3 +89,251 @@ func getSecret(ctx context.Context, clientV2 *edgecloudV2.Client, d *schema.Reso
 
 	return secret, nil
 }
+
+// parseSecretCertificate returns the first certificate of a PEM bundle.
+func parseSecretCertificate(certificate string) (*x509.Certificate, error) {
+	rest := []byte(certificate)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("no PEM encoded certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// secretCertificateMetadata returns the values of the certificate metadata fields. All of them are
+// empty when the certificate cannot be parsed.
+func secretCertificateMetadata(certificate string) map[string]interface{} {
+	metadata := map[string]interface{}{
+		SecretNotAfterField:                "",
+		SecretSubjectAlternativeNamesField: []interface{}{},
+		SecretIssuerField:                  "",
+		SecretFingerprintField:             "",
+	}
+
+	cert, err := parseSecretCertificate(certificate)
+	if err != nil {
+		log.Printf("[WARN] Cannot parse the secret certificate: %s", err)
+		return metadata
+	}
+
+	names := make([]interface{}, 0, len(cert.DNSNames)+len(cert.IPAddresses)+len(cert.EmailAddresses)+len(cert.URIs))
+	for _, name := range cert.DNSNames {
+		names = append(names, name)
+	}
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	for _, email := range cert.EmailAddresses {
+		names = append(names, email)
+	}
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+
+	fingerprint := sha256.Sum256(cert.Raw)
+
+	metadata[SecretNotAfterField] = cert.NotAfter.UTC().Format(time.RFC3339)
+	metadata[SecretSubjectAlternativeNamesField] = names
+	metadata[SecretIssuerField] = cert.Issuer.String()
+	metadata[SecretFingerprintField] = hex.EncodeToString(fingerprint[:])
+
+	return metadata
+}
+
+func setSecretCertificateMetadata(d *schema.ResourceData, certificate string) error {
+	for field, value := range secretCertificateMetadata(certificate) {
+		if err := d.Set(field, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// customizeSecretDiff plans the certificate metadata of a new certificate and the values
+// that change when the secret is rotated.
+func customizeSecretDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && !d.HasChanges(secretRotationFields...) {
+		return nil
+	}
+
+	if d.Id() != "" {
+		for _, field := range []string{"secret_id", "status", "created"} {
+			if err := d.SetNewComputed(field); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.NewValueKnown("certificate") {
+		for _, field := range secretCertificateMetadataFields {
+			if err := d.SetNewComputed(field); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for field, value := range secretCertificateMetadata(d.Get("certificate").(string)) {
+		if err := d.SetNew(field, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// secretExpirationWarnings warns about a secret expiration date or a certificate that expires within
+// the given number of days. A window of zero days disables the warnings.
+func secretExpirationWarnings(expiration, certificate string, days int, now time.Time) diag.Diagnostics {
+	if days <= 0 {
+		return nil
+	}
+	deadline := now.AddDate(0, 0, days)
+
+	var diags diag.Diagnostics
+	warn := func(what string, at time.Time, path cty.Path) {
+		detail := fmt.Sprintf("The %s is %s.", what, at.UTC().Format(time.RFC3339))
+		summary := fmt.Sprintf("The %s is within %d days", what, days)
+		if at.Before(now) {
+			summary = fmt.Sprintf("The %s has passed", what)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       summary,
+			Detail:        detail + " Rotate the secret before it expires to keep the listeners that use it working.",
+			AttributePath: path,
+		})
+	}
+
+	if expiration != "" {
+		if at, err := time.Parse(RFC3339NoZ, expiration); err == nil && at.Before(deadline) {
+			warn("secret expiration date", at, cty.GetAttrPath("expiration"))
+		}
+	}
+
+	if certificate != "" {
+		if cert, err := parseSecretCertificate(certificate); err == nil && cert.NotAfter.Before(deadline) {
+			warn("certificate expiration date", cert.NotAfter, cty.GetAttrPath("certificate"))
+		}
+	}
+
+	return diags
+}
+
+// validateSecretExpiration is run on every validation, so the warning shows up in each plan
+// once the expiration date gets close.
+func validateSecretExpiration(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if req.RawConfig.IsNull() || !req.RawConfig.IsWhollyKnown() {
+		return
+	}
+
+	days := SecretExpirationWarningDaysDefault
+	if v := req.RawConfig.GetAttr(SecretExpirationWarningDaysField); !v.IsNull() {
+		n, _ := v.AsBigFloat().Int64()
+		days = int(n)
+	}
+
+	var expiration, certificate string
+	if v := req.RawConfig.GetAttr("expiration"); !v.IsNull() {
+		expiration = v.AsString()
+	}
+	if v := req.RawConfig.GetAttr("certificate"); !v.IsNull() {
+		certificate = v.AsString()
+	}
+
+	resp.Diagnostics = append(resp.Diagnostics, secretExpirationWarnings(expiration, certificate, days, time.Now())...)
+}
+
+// repointListenerSecret returns the update request that moves a listener from the old secret to
+// the new one, or nil when the listener does not use the old secret.
+func repointListenerSecret(listener edgecloudV2.Listener, oldID, newID string) *edgecloudV2.ListenerUpdateRequest {
+	var changed bool
+	opts := &edgecloudV2.ListenerUpdateRequest{Name: listener.Name}
+
+	if listener.SecretID == oldID {
+		opts.SecretID = newID
+		changed = true
+	}
+
+	if slices.Contains(listener.SNISecretID, oldID) {
+		sniSecretID := make([]string, len(listener.SNISecretID))
+		for i, id := range listener.SNISecretID {
+			if id == oldID {
+				id = newID
+			}
+			sniSecretID[i] = id
+		}
+		opts.SNISecretID = sniSecretID
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return opts
+}
+
+// repointListeners moves every listener of the project from the old secret to the new one,
+// including the listeners managed outside of Terraform. It returns the IDs of the moved listeners,
+// also when it fails part way.
+func repointListeners(ctx context.Context, clientV2 *edgecloudV2.Client, oldID, newID string) ([]string, error) {
+	listeners, _, err := clientV2.Loadbalancers.ListenerList(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list listeners: %w", err)
+	}
+
+	var moved []string
+	for _, listener := range listeners {
+		opts := repointListenerSecret(listener, oldID, newID)
+		if opts == nil {
+			continue
+		}
+
+		log.Printf("[DEBUG] Moving listener %s from secret %s to secret %s", listener.ID, oldID, newID)
+		task, _, err := clientV2.Loadbalancers.ListenerUpdate(ctx, listener.ID, opts)
+		if err != nil {
+			return moved, fmt.Errorf("cannot move listener %s to secret %s: %w", listener.ID, newID, err)
+		}
+		if err := utilV2.WaitForTaskComplete(ctx, clientV2, task.Tasks[0], LBListenerUpdateTimeout); err != nil {
+			return moved, fmt.Errorf("cannot move listener %s to secret %s: %w", listener.ID, newID, err)
+		}
+		moved = append(moved, listener.ID)
+	}
+
+	return moved, nil
+}
+
+// rollbackSecretRotation moves the listeners back to the old secret and deletes the new one.
+// Errors are only logged, since the rotation has already failed.
+func rollbackSecretRotation(ctx context.Context, clientV2 *edgecloudV2.Client, oldID, newID string, moved []string, timeout time.Duration) {
+	for _, listenerID := range moved {
+		listener, _, err := clientV2.Loadbalancers.ListenerGet(ctx, listenerID)
+		if err != nil {
+			log.Printf("[WARN] Cannot move listener %s back to secret %s: %s", listenerID, oldID, err)
+			continue
+		}
+		opts := repointListenerSecret(*listener, newID, oldID)
+		if opts == nil {
+			continue
+		}
+		task, _, err := clientV2.Loadbalancers.ListenerUpdate(ctx, listenerID, opts)
+		if err == nil {
+			err = utilV2.WaitForTaskComplete(ctx, clientV2, task.Tasks[0], LBListenerUpdateTimeout)
+		}
+		if err != nil {
+			log.Printf("[WARN] Cannot move listener %s back to secret %s: %s", listenerID, oldID, err)
+			return
+		}
+	}
+
+	if err := deleteSecret(ctx, clientV2, newID, timeout); err != nil {
+		log.Printf("[WARN] Cannot delete secret %s: %s", newID, err)
+	}
+}
@@ -0,0 +1,153 @@
+package edgecenter
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func testSecretCertificate(t *testing.T, notAfter time.Time) (string, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		Issuer:       pkix.Name{CommonName: "example.com"},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+		DNSNames:     []string{"example.com", "www.example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("192.0.2.10")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), der
+}
+
+func TestSecretCertificateMetadata(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	certificate, der := testSecretCertificate(t, notAfter)
+	fingerprint := sha256.Sum256(der)
+
+	metadata := secretCertificateMetadata(certificate)
+	if got := metadata[SecretNotAfterField]; got != "2030-01-02T03:04:05Z" {
+		t.Errorf("not_after = %v, want 2030-01-02T03:04:05Z", got)
+	}
+	if got := metadata[SecretIssuerField]; got != "CN=example.com" {
+		t.Errorf("issuer = %v, want CN=example.com", got)
+	}
+	if got := metadata[SecretFingerprintField]; got != hex.EncodeToString(fingerprint[:]) {
+		t.Errorf("fingerprint = %v, want the SHA-256 of the certificate", got)
+	}
+	names := metadata[SecretSubjectAlternativeNamesField].([]interface{})
+	if len(names) != 3 || names[0] != "example.com" || names[1] != "www.example.com" || names[2] != "192.0.2.10" {
+		t.Errorf("subject_alternative_names = %v, want the DNS names and the IP address", names)
+	}
+
+	empty := secretCertificateMetadata("cert-pem")
+	if empty[SecretNotAfterField] != "" || empty[SecretFingerprintField] != "" || len(empty[SecretSubjectAlternativeNamesField].([]interface{})) != 0 {
+		t.Errorf("metadata of an invalid certificate = %v, want empty values", empty)
+	}
+}
+
+func TestSecretExpirationWarnings(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	soon, _ := testSecretCertificate(t, now.AddDate(0, 0, 10))
+	later, _ := testSecretCertificate(t, now.AddDate(1, 0, 0))
+
+	tests := []struct {
+		name        string
+		expiration  string
+		certificate string
+		days        int
+		want        int
+	}{
+		{"nothing expires", "2027-01-01T00:00:00", later, 30, 0},
+		{"expiration within window", "2026-01-20T00:00:00", later, 30, 1},
+		{"expiration passed", "2025-12-01T00:00:00", later, 30, 1},
+		{"certificate within window", "", soon, 30, 1},
+		{"both within window", "2026-01-20T00:00:00", soon, 30, 2},
+		{"window shorter than the expiration", "2026-01-20T00:00:00", soon, 5, 0},
+		{"warnings disabled", "2026-01-20T00:00:00", soon, 0, 0},
+		{"invalid certificate", "", "cert-pem", 30, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := secretExpirationWarnings(tt.expiration, tt.certificate, tt.days, now)
+			if len(diags) != tt.want {
+				t.Fatalf("got %d diagnostics, want %d: %v", len(diags), tt.want, diags)
+			}
+			if diags.HasError() {
+				t.Errorf("got error diagnostics, want warnings: %v", diags)
+			}
+		})
+	}
+}
+
+func TestRepointListenerSecret(t *testing.T) {
+	tests := []struct {
+		name     string
+		listener edgecloudV2.Listener
+		wantNil  bool
+		wantID   string
+		wantSNI  []string
+	}{
+		{
+			name:     "default certificate",
+			listener: edgecloudV2.Listener{Name: "https", SecretID: "old", SNISecretID: []string{"other"}},
+			wantID:   "new",
+		},
+		{
+			name:     "sni certificate",
+			listener: edgecloudV2.Listener{Name: "https", SecretID: "other", SNISecretID: []string{"first", "old"}},
+			wantSNI:  []string{"first", "new"},
+		},
+		{
+			name:     "secret not used",
+			listener: edgecloudV2.Listener{Name: "https", SecretID: "other"},
+			wantNil:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := repointListenerSecret(tt.listener, "old", "new")
+			if tt.wantNil {
+				if opts != nil {
+					t.Fatalf("got %+v, want nil", opts)
+				}
+				return
+			}
+			if opts == nil {
+				t.Fatal("got nil, want an update request")
+			}
+			if opts.Name != tt.listener.Name || opts.SecretID != tt.wantID {
+				t.Errorf("got name %q and secret %q, want %q and %q", opts.Name, opts.SecretID, tt.listener.Name, tt.wantID)
+			}
+			if len(opts.SNISecretID) != len(tt.wantSNI) {
+				t.Fatalf("sni secrets = %v, want %v", opts.SNISecretID, tt.wantSNI)
+			}
+			for i := range tt.wantSNI {
+				if opts.SNISecretID[i] != tt.wantSNI[i] {
+					t.Errorf("sni secrets = %v, want %v", opts.SNISecretID, tt.wantSNI)
+				}
+			}
+		})
+	}
+}
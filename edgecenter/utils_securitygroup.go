@@ -11,6 +11,8 @@ import (
 	"io"
 	"strconv"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
@@ -29,6 +31,103 @@ var networkProtocolWithPort = map[edgecloudV2.SecurityGroupRuleProtocol]struct{}
 	edgecloudV2.SGRuleProtocolDCCP:    {},
 }
 
+// validateSecurityGroupRuleDirection checks that the rule direction is ingress or egress.
+func validateSecurityGroupRuleDirection(v interface{}, _ cty.Path) diag.Diagnostics {
+	val := v.(string)
+	switch edgecloudV2.SecurityGroupRuleDirection(val) {
+	case edgecloudV2.SGRuleDirectionIngress, edgecloudV2.SGRuleDirectionEgress:
+		return nil
+	}
+	return diag.Errorf("wrong direction '%s', available value is '%s', '%s'", val, edgecloudV2.SGRuleDirectionIngress, edgecloudV2.SGRuleDirectionEgress)
+}
+
+// validateSecurityGroupRuleEtherType checks that the rule ethertype is IPv4 or IPv6.
+func validateSecurityGroupRuleEtherType(v interface{}, _ cty.Path) diag.Diagnostics {
+	val := v.(string)
+	switch edgecloudV2.EtherType(val) {
+	case edgecloudV2.EtherTypeIPv4, edgecloudV2.EtherTypeIPv6:
+		return nil
+	}
+	return diag.Errorf("wrong ethertype '%s', available value is '%s', '%s'", val, edgecloudV2.EtherTypeIPv4, edgecloudV2.EtherTypeIPv6)
+}
+
+// flattenSecurityGroupRule converts a security group rule returned by the API to the map of the
+// security_group_rules items. Ports missing for protocols with ports mean the whole range.
+func flattenSecurityGroupRule(sgr edgecloudV2.SecurityGroupRule) map[string]interface{} {
+	r := make(map[string]interface{})
+	r["id"] = sgr.ID
+	r["direction"] = sgr.Direction.String()
+
+	if sgr.EtherType != nil {
+		r["ethertype"] = sgr.EtherType.String()
+	}
+
+	r["protocol"] = edgecloudV2.SGRuleProtocolANY.String()
+	if sgr.Protocol != nil {
+		r["protocol"] = sgr.Protocol.String()
+	}
+
+	// Some client can create rules with protocol TCP and UDP in all ports range(1-65535)
+	// In this case API return nil for PortRangeMax and PortRangeMin,
+	// and we need to set it manually.
+	sgProtocol := edgecloudV2.SecurityGroupRuleProtocol(r["protocol"].(string))
+
+	r["port_range_max"] = 0
+	if _, ok := networkProtocolWithPort[sgProtocol]; ok {
+		r["port_range_max"] = 65535
+	}
+
+	if sgr.PortRangeMax != nil {
+		r["port_range_max"] = *sgr.PortRangeMax
+	}
+
+	r["port_range_min"] = 0
+	if _, ok := networkProtocolWithPort[sgProtocol]; ok {
+		r["port_range_min"] = 1
+	}
+
+	if sgr.PortRangeMin != nil {
+		r["port_range_min"] = *sgr.PortRangeMin
+	}
+
+	r["description"] = ""
+	if sgr.Description != nil {
+		r["description"] = *sgr.Description
+	}
+
+	r["remote_ip_prefix"] = ""
+	if sgr.RemoteIPPrefix != nil {
+		r["remote_ip_prefix"] = *sgr.RemoteIPPrefix
+	}
+
+	r["updated_at"] = sgr.UpdatedAt
+	r["created_at"] = sgr.CreatedAt
+
+	return r
+}
+
+// managedSecurityGroupRules keeps the rules of the group the resource manages when it does not
+// manage them exclusively: the rules with an id known to the state or matching a rule of the
+// configuration. Rules added by edgecenter_securitygroup_rule or outside terraform are skipped.
+func managedSecurityGroupRules(known *schema.Set, rules []interface{}) []interface{} {
+	ids := make(map[string]bool, known.Len())
+	for _, r := range known.List() {
+		if id, _ := r.(map[string]interface{})["id"].(string); id != "" {
+			ids[id] = true
+		}
+	}
+
+	managed := make([]interface{}, 0, len(rules))
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		if ids[rule["id"].(string)] || known.Contains(r) {
+			managed = append(managed, r)
+		}
+	}
+
+	return managed
+}
+
 // secGroupUniqueID generates a unique ID for a security group rule using its properties.
 func secGroupUniqueID(i interface{}) int {
 	e := i.(map[string]interface{})
@@ -79,6 +178,10 @@ func extractSecurityGroupRuleCreateRequestV2(r interface{}, gid string) (edgeclo
 		opts.RemoteIPPrefix = &remoteIPPrefix
 	}
 
+	if remoteGroupID, _ := rule["remote_group_id"].(string); remoteGroupID != "" {
+		opts.RemoteGroupID = &remoteGroupID
+	}
+
 	return opts, nil
 }
 
@@ -100,7 +203,9 @@ func extractSecurityGroupRuleUpdateRequestV2(r interface{}, gid string) (edgeclo
 		return edgecloudV2.RuleUpdateRequest{}, err
 	}
 
-	opts.PortRangeMin, opts.PortRangeMax = *portRangeMin, *portRangeMax
+	if portRangeMin != nil && portRangeMax != nil {
+		opts.PortRangeMin, opts.PortRangeMax = *portRangeMin, *portRangeMax
+	}
 
 	description, _ := rule["description"].(string)
 	opts.Description = description
@@ -110,6 +215,10 @@ func extractSecurityGroupRuleUpdateRequestV2(r interface{}, gid string) (edgeclo
 		opts.RemoteIPPrefix = remoteIPPrefix
 	}
 
+	if remoteGroupID, _ := rule["remote_group_id"].(string); remoteGroupID != "" {
+		opts.RemoteGroupID = remoteGroupID
+	}
+
 	return opts, nil
 }
 
@@ -0,0 +1,44 @@
+package edgecenter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func securityGroupRuleItem(id, direction, protocol string, port int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               id,
+		"direction":        direction,
+		"ethertype":        "IPv4",
+		"protocol":         protocol,
+		"port_range_min":   port,
+		"port_range_max":   port,
+		"description":      "",
+		"remote_ip_prefix": "",
+	}
+}
+
+func TestManagedSecurityGroupRules(t *testing.T) {
+	known := schema.NewSet(secGroupUniqueID, []interface{}{
+		securityGroupRuleItem("rule-egress", "egress", "any", 0),
+		// A rule of the configuration that is not created yet has no id.
+		securityGroupRuleItem("", "ingress", "tcp", 443),
+	})
+
+	rules := []interface{}{
+		securityGroupRuleItem("rule-egress", "egress", "any", 0),
+		securityGroupRuleItem("rule-https", "ingress", "tcp", 443),
+		securityGroupRuleItem("rule-ssh", "ingress", "tcp", 22),
+	}
+
+	got := managedSecurityGroupRules(known, rules)
+	if len(got) != 2 {
+		t.Fatalf("managed rules = %v, want rule-egress and rule-https", got)
+	}
+	for i, id := range []string{"rule-egress", "rule-https"} {
+		if got[i].(map[string]interface{})["id"] != id {
+			t.Errorf("rule %d = %v, want %s", i, got[i], id)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_project" "pr" {
+  name = "test"
+}
+
+data "edgecenter_region" "rg" {
+  name = "ED-10 Preprod"
+}
+
+data "edgecenter_loadbalancers" "list" {
+  region_id   = data.edgecenter_region.rg.id
+  project_id  = data.edgecenter_project.pr.id
+  status      = "ACTIVE"
+  metadata_kv = {
+    env = "prod"
+  }
+}
+
+output "vip_addresses" {
+  value = data.edgecenter_loadbalancers.list.load_balancers[*].vip_address
+}
@@ -0,0 +1,11 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_quota" "quota" {
+  region_id = 1
+}
+
+output "remaining_cpu" {
+  value = data.edgecenter_quota.quota.remaining["cpu_count"]
+}
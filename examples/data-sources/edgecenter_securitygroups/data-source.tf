@@ -0,0 +1,21 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_project" "pr" {
+  name = "test"
+}
+
+data "edgecenter_region" "rg" {
+  name = "ED-10 Preprod"
+}
+
+data "edgecenter_securitygroups" "list" {
+  region_id  = data.edgecenter_region.rg.id
+  project_id = data.edgecenter_project.pr.id
+  metadata_k = "managed-by"
+}
+
+output "security_group_ids" {
+  value = data.edgecenter_securitygroups.list.security_groups[*].id
+}
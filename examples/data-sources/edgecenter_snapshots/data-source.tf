@@ -0,0 +1,22 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_project" "pr" {
+  name = "test"
+}
+
+data "edgecenter_region" "rg" {
+  name = "ED-10 Preprod"
+}
+
+data "edgecenter_snapshots" "policy" {
+  region_id           = data.edgecenter_region.rg.id
+  project_id          = data.edgecenter_project.pr.id
+  lifecycle_policy_id = edgecenter_lifecyclepolicy.lp.id
+  status              = "available"
+}
+
+output "latest_snapshot_id" {
+  value = data.edgecenter_snapshots.policy.snapshots[0].snapshot_id
+}
@@ -0,0 +1,22 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_project" "pr" {
+  name = "test"
+}
+
+data "edgecenter_region" "rg" {
+  name = "ED-10 Preprod"
+}
+
+data "edgecenter_subnets" "list" {
+  region_id  = data.edgecenter_region.rg.id
+  project_id = data.edgecenter_project.pr.id
+  network_id = "b30d0de7-bca2-4c83-9c57-9e645bd2cc92"
+  name_regex = "^private-"
+}
+
+output "subnet_cidrs" {
+  value = data.edgecenter_subnets.list.subnets[*].cidr
+}
@@ -0,0 +1,25 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_project" "pr" {
+  name = "test"
+}
+
+data "edgecenter_region" "rg" {
+  name = "ED-10 Preprod"
+}
+
+data "edgecenter_volumes" "list" {
+  region_id   = data.edgecenter_region.rg.id
+  project_id  = data.edgecenter_project.pr.id
+  name_regex  = "^backup-"
+  status      = "available"
+  metadata_kv = {
+    env = "prod"
+  }
+}
+
+output "volume_ids" {
+  value = data.edgecenter_volumes.list.volumes[*].id
+}
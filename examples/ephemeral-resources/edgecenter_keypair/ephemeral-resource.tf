@@ -0,0 +1,9 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+# The key pair is deleted when terraform finishes, its private key is not stored in the plan or the state
+ephemeral "edgecenter_keypair" "kp" {
+  project_id  = 1
+  sshkey_name = "temporary"
+}
@@ -0,0 +1,2 @@
+# import using <project_id>:<region_id>:<image_id> format
+terraform import edgecenter_image.image1 1:6:447d2959-8ae0-4ca0-8d47-9f050a3637d7
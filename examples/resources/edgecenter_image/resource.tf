@@ -0,0 +1,26 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_image" "golden" {
+  name       = "golden-ubuntu-22.04"
+  url        = "https://images.example.com/golden-ubuntu-22.04.qcow2"
+  os_distro  = "ubuntu"
+  os_version = "22.04"
+
+  metadata_map = {
+    build = "packer"
+  }
+
+  region_id  = 1
+  project_id = 1
+}
+
+resource "edgecenter_image" "from_volume" {
+  name             = "image-from-volume"
+  volume_id        = "5b7ed1f9-8c05-4d3f-80e5-7f2e4d0c5b1e"
+  hw_firmware_type = "uefi"
+
+  region_id  = 1
+  project_id = 1
+}
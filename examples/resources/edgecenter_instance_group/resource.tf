@@ -0,0 +1,97 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+variable "region_id" {
+  type        = number
+  description = "The region id variable indicates in which region the resource should be created"
+  default     = 1
+}
+
+variable "project_id" {
+  type        = number
+  description = "The project id variable specifies in which project the resource should be created"
+  default     = 1
+}
+
+variable "image_id" {
+  type        = string
+  description = "The ID of the image the boot volumes of the members are created from."
+  default     = "f4ce3d30-e29c-4cfd-811f-46f383b6081f"
+}
+
+resource "edgecenter_network" "network" {
+  name       = "network_example"
+  type       = "vxlan"
+  region_id  = var.region_id
+  project_id = var.project_id
+}
+
+resource "edgecenter_subnet" "subnet" {
+  name       = "subnet_example"
+  cidr       = "192.168.10.0/24"
+  network_id = edgecenter_network.network.id
+  region_id  = var.region_id
+  project_id = var.project_id
+}
+
+resource "edgecenter_loadbalancerv2" "lb" {
+  name       = "lb_example"
+  flavor     = "lb1-1-2"
+  region_id  = var.region_id
+  project_id = var.project_id
+}
+
+resource "edgecenter_lblistener" "listener" {
+  name            = "listener_example"
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = edgecenter_loadbalancerv2.lb.id
+  region_id       = var.region_id
+  project_id      = var.project_id
+}
+
+resource "edgecenter_lbpool" "pool" {
+  name            = "pool_example"
+  protocol        = "HTTP"
+  lb_algorithm    = "ROUND_ROBIN"
+  loadbalancer_id = edgecenter_loadbalancerv2.lb.id
+  listener_id     = edgecenter_lblistener.listener.id
+  region_id       = var.region_id
+  project_id      = var.project_id
+}
+
+resource "edgecenter_instance_group" "workers" {
+  name_template  = "worker-{ip_octets}"
+  instance_count = 3
+  flavor_id      = "g1-standard-2-4"
+  keypair_name   = "my_keypair"
+  region_id      = var.region_id
+  project_id     = var.project_id
+
+  boot_volume {
+    image_id  = var.image_id
+    size      = 10
+    type_name = "ssd_hiiops"
+  }
+
+  interfaces {
+    type       = "subnet"
+    network_id = edgecenter_network.network.id
+    subnet_id  = edgecenter_subnet.subnet.id
+  }
+
+  lb_pool {
+    pool_id       = edgecenter_lbpool.pool.id
+    protocol_port = 8080
+    subnet_id     = edgecenter_subnet.subnet.id
+  }
+
+  metadata = {
+    role = "worker"
+  }
+}
+
+output "worker_addresses" {
+  value = edgecenter_instance_group.workers.members[*].ip_address
+}
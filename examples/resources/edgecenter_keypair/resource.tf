@@ -11,3 +11,14 @@ resource "edgecenter_keypair" "kp" {
 output "kp" {
   value = edgecenter_keypair.kp
 }
+
+# The key pair is generated when no public key is set, its private key is stored in the state
+resource "edgecenter_keypair" "generated" {
+  project_id  = 1
+  sshkey_name = "generated"
+}
+
+output "generated_private_key" {
+  value     = edgecenter_keypair.generated.private_key
+  sensitive = true
+}
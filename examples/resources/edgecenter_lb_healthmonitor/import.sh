@@ -0,0 +1,2 @@
+# import using <project_id>:<region_id>:<healthmonitor_id>:<pool_id> format
+terraform import edgecenter_lb_healthmonitor.hm1 1:6:5c5e1d6b-6e43-4d36-9f07-2e9a3f1c8d44:447d2959-8ae0-4ca0-8d47-9f050a3637d7
\ No newline at end of file
@@ -0,0 +1,43 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_loadbalancerv2" "lb" {
+  project_id = 1
+  region_id  = 1
+  name       = "test"
+  flavor     = "lb1-1-2"
+}
+
+resource "edgecenter_lblistener" "listener" {
+  project_id      = 1
+  region_id       = 1
+  name            = "test"
+  protocol        = "HTTP"
+  protocol_port   = 80
+  loadbalancer_id = edgecenter_loadbalancerv2.lb.id
+}
+
+resource "edgecenter_lbpool" "pl" {
+  project_id            = 1
+  region_id             = 1
+  name                  = "test_pool1"
+  protocol              = "HTTP"
+  lb_algorithm          = "ROUND_ROBIN"
+  loadbalancer_id       = edgecenter_loadbalancerv2.lb.id
+  listener_id           = edgecenter_lblistener.listener.id
+  ignore_health_monitor = true
+}
+
+resource "edgecenter_lb_healthmonitor" "hm" {
+  project_id     = 1
+  region_id      = 1
+  pool_id        = edgecenter_lbpool.pl.id
+  type           = "HTTP"
+  delay          = 10
+  timeout        = 5
+  max_retries    = 3
+  http_method    = "GET"
+  url_path       = "/health"
+  expected_codes = "200-204"
+}
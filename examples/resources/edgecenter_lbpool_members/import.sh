@@ -0,0 +1,2 @@
+# import using <project_id>:<region_id>:<pool_id> format
+terraform import edgecenter_lbpool_members.members 1:6:447d2959-8ae0-4ca0-8d47-9f050a3637d7
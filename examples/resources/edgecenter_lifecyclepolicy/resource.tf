@@ -27,4 +27,24 @@ resource "edgecenter_lifecyclepolicy" "lp" {
       minutes = 1
     }
   }
-}
\ No newline at end of file
+}
+
+resource "edgecenter_lifecyclepolicy" "instances" {
+  project_id   = 1
+  region_id    = 1
+  name         = "instances"
+  instance_ids = ["2b1f8c3e-6d4a-4e2f-9a1b-7c3d5e8f0a12"]
+  instance_selector {
+    metadata_kv = {
+      backup = "daily"
+    }
+  }
+  schedule {
+    max_quantity = 7
+    cron {
+      hour   = "2"
+      minute = "0"
+    }
+    resource_name_template = "daily snap of the volume {volume_id}"
+  }
+}
@@ -0,0 +1,36 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_port" "vrrp" {
+  network_id       = "9f0d7f5e-50c8-4d6b-9a0a-2fcd1fdb2a32"
+  fixed_ip_address = "192.168.10.5"
+
+  allowed_address_pairs {
+    ip_address = "192.168.10.100"
+  }
+
+  security_group_ids = ["2bf3a5d7-9072-40aa-8ac0-a64e39427a2c"]
+
+  region_id  = 1
+  project_id = 1
+}
+
+resource "edgecenter_instanceV2" "instance" {
+  flavor_id = "g1-standard-2-4"
+  name      = "test"
+
+  boot_volumes {
+    volume_id  = "5b7ed1f9-8c05-4d3f-80e5-7f2e4d0c5b1e"
+    boot_index = 0
+  }
+
+  interfaces {
+    is_default                = true
+    type                      = "reserved_fixed_ip"
+    reserved_fixed_ip_port_id = edgecenter_port.vrrp.id
+  }
+
+  region_id  = 1
+  project_id = 1
+}
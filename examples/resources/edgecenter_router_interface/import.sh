@@ -0,0 +1,2 @@
+# import using <project_id>:<region_id>:<router_id>:<subnet_id> format
+terraform import edgecenter_router_interface.router_interface1 1:6:447d2959-8ae0-4ca0-8d47-9f050a3637d7:e7a8b3c1-5f1e-4a3b-9c3d-2f7a1b0c9d8e
\ No newline at end of file
@@ -0,0 +1,33 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_router" "router" {
+  name                     = "router_example"
+  non_exclusive_interfaces = true
+  region_id                = 1
+  project_id               = 1
+}
+
+resource "edgecenter_network" "network" {
+  name       = "network_example"
+  type       = "vxlan"
+  region_id  = 1
+  project_id = 1
+}
+
+resource "edgecenter_subnet" "subnet" {
+  name       = "subnet_example"
+  cidr       = "192.168.10.0/24"
+  network_id = edgecenter_network.network.id
+  gateway_ip = "192.168.10.1"
+  region_id  = 1
+  project_id = 1
+}
+
+resource "edgecenter_router_interface" "router_interface" {
+  router_id  = edgecenter_router.router.id
+  subnet_id  = edgecenter_subnet.subnet.id
+  region_id  = 1
+  project_id = 1
+}
@@ -0,0 +1,2 @@
+# import using <project_id>:<region_id>:<router_id>:<destination> format
+terraform import edgecenter_router_route.router_route1 1:6:447d2959-8ae0-4ca0-8d47-9f050a3637d7:10.0.3.0/24
\ No newline at end of file
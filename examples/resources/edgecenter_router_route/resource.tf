@@ -0,0 +1,18 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_router" "router" {
+  name                 = "router_example"
+  non_exclusive_routes = true
+  region_id            = 1
+  project_id           = 1
+}
+
+resource "edgecenter_router_route" "router_route" {
+  router_id   = edgecenter_router.router.id
+  destination = "10.0.3.0/24"
+  nexthop     = "192.168.10.13"
+  region_id   = 1
+  project_id  = 1
+}
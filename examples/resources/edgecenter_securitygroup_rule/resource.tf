@@ -0,0 +1,36 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_securitygroup" "bastion" {
+  name                = "bastion"
+  region_id           = 1
+  project_id          = 1
+  non_exclusive_rules = true
+
+  security_group_rules {
+    direction = "egress"
+    ethertype = "IPv4"
+    protocol  = "any"
+  }
+}
+
+resource "edgecenter_securitygroup" "app" {
+  name                = "app"
+  region_id           = 1
+  project_id          = 1
+  non_exclusive_rules = true
+}
+
+# SSH to the app servers only from the members of the bastion group
+resource "edgecenter_securitygroup_rule" "ssh" {
+  region_id         = 1
+  project_id        = 1
+  security_group_id = edgecenter_securitygroup.app.id
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  port_range_min    = 22
+  port_range_max    = 22
+  remote_group_id   = edgecenter_securitygroup.bastion.id
+}
@@ -0,0 +1,21 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_volume" "data" {
+  name       = "data volume"
+  type_name  = "ssd_hiiops"
+  size       = 10
+  region_id  = 1
+  project_id = 1
+}
+
+# The instance is declared with non_exclusive_data_volumes = true,
+# so it keeps the volumes attached by edgecenter_volume_attachment.
+resource "edgecenter_volume_attachment" "data" {
+  region_id      = 1
+  project_id     = 1
+  instance_id    = edgecenter_instanceV2.instance.id
+  volume_id      = edgecenter_volume.data.id
+  attachment_tag = "data"
+}
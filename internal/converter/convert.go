@@ -12,6 +12,9 @@ type Options struct {
 	Dir            string
 	StatePath      string
 	MigrationsPath string
+	// Import writes removed and import blocks instead of moved blocks, for terraform before 1.8
+	// or provider versions that cannot move the state of v1 resources.
+	Import bool
 }
 
 type Result struct {
@@ -169,7 +172,7 @@ func Run(opts Options) (*Result, error) {
 		changed[filepath.Join(opts.Dir, f.path)] = out
 	}
 	if len(migEntries) > 0 {
-		changed[opts.MigrationsPath] = []byte(buildMigrations(migEntries, rep))
+		changed[opts.MigrationsPath] = []byte(buildMigrations(migEntries, rep, opts.Import))
 	} else {
 		rep.Migrations = ""
 	}
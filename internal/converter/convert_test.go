@@ -92,3 +92,35 @@ func TestGolden(t *testing.T) {
 		})
 	}
 }
+
+func TestImportWritesRemovedAndImportBlocks(t *testing.T) {
+	t.Parallel()
+	dir := filepath.Join("testdata", "basic", "in")
+	migrations := filepath.Join(dir, "v2-migrate.tf")
+	res, err := Run(Options{
+		Dir:            dir,
+		StatePath:      filepath.Join(dir, "terraform.tfstate"),
+		MigrationsPath: migrations,
+		Import:         true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := string(res.Changed[migrations])
+	if strings.Contains(got, "\nmoved {") {
+		t.Errorf("moved block written with Import set:\n%s", got)
+	}
+	for _, want := range []string{
+		"removed {\n  from = edgecenter_instance.web\n",
+		"import {\n  to = edgecenter_instanceV2.web\n  id = \"1:2:447d2959-8ae0-4ca0-8d47-9f050a3637d7\"\n}",
+		"# Requires terraform >= 1.7.",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("migration file misses %q:\n%s", want, got)
+		}
+	}
+	if res.Report.MovedN != 0 || res.Report.RemovedN != 3 {
+		t.Errorf("moved = %d, removed = %d, want 0 and 3", res.Report.MovedN, res.Report.RemovedN)
+	}
+}
@@ -27,29 +27,42 @@ func renderIndexKey(key any) string {
 	return ""
 }
 
-func buildMigrations(entries []migrationEntry, rep *Report) string {
+func buildMigrations(entries []migrationEntry, rep *Report, useImport bool) string {
+	var body strings.Builder
+	for _, e := range entries {
+		if e.extract != nil {
+			writeExtractImport(&body, e, rep)
+			continue
+		}
+		writeResourceMigration(&body, e, rep, useImport)
+	}
+
 	var b strings.Builder
 	b.WriteString("# Generated by v2migrate (terraform-provider-edgecenter).\n")
 	b.WriteString("# Review the blocks, then run:\n")
 	b.WriteString("#   terraform plan -out=v2-migrate.tfplan\n")
 	b.WriteString("#   terraform apply v2-migrate.tfplan\n")
-	b.WriteString("# The plan must only import and forget resources, no destroy and no create.\n")
+	b.WriteString("# The plan must only move, import and forget resources, no destroy and no create.\n")
 	b.WriteString("# After a successful apply delete this file, terraform plan must then show no changes.\n")
-	b.WriteString("# Requires terraform >= 1.7.\n")
-
-	for _, e := range entries {
-		if e.extract != nil {
-			writeExtractImport(&b, e, rep)
-			continue
-		}
-		writeResourceMigration(&b, e, rep)
+	if rep.MovedN > 0 {
+		// moved blocks across resource types need terraform 1.8.
+		b.WriteString("# Requires terraform >= 1.8.\n")
+	} else {
+		b.WriteString("# Requires terraform >= 1.7.\n")
 	}
+	b.WriteString(body.String())
 
 	return b.String()
 }
 
-func writeResourceMigration(b *strings.Builder, e migrationEntry, rep *Report) {
+func writeResourceMigration(b *strings.Builder, e migrationEntry, rep *Report, useImport bool) {
 	c := e.conv
+	if e.rules.Moved && !useImport {
+		fmt.Fprintf(b, "\nmoved {\n  from = %s\n  to   = %s\n}\n", c.oldAddr, c.newAddr)
+		rep.MovedN++
+		return
+	}
+
 	fmt.Fprintf(b, "\nremoved {\n  from = %s\n  lifecycle {\n    destroy = false\n  }\n}\n", c.oldAddr)
 	rep.RemovedN++
 
@@ -33,6 +33,7 @@ type Report struct {
 	Todos       []Finding
 	Warns       []Finding
 	Infos       []Finding
+	MovedN      int
 	RemovedN    int
 	ImportsN    int
 	Migrations  string
@@ -103,7 +104,7 @@ func (r *Report) Render() string {
 
 	if r.Migrations != "" {
 		b.WriteString("## State migration\n\n")
-		fmt.Fprintf(&b, "- %s: %d removed block(s), %d import block(s)\n", r.Migrations, r.RemovedN, r.ImportsN)
+		fmt.Fprintf(&b, "- %s: %d moved block(s), %d removed block(s), %d import block(s)\n", r.Migrations, r.MovedN, r.RemovedN, r.ImportsN)
 		if r.Placeholder {
 			b.WriteString("- some import ids could not be resolved from state and contain <placeholders>, fill them before applying\n")
 		}
@@ -131,7 +132,7 @@ func (r *Report) Render() string {
 	b.WriteString("## Next steps\n\n")
 	b.WriteString("1. Review the rewritten manifests and every TODO(v2migrate) marker.\n")
 	b.WriteString("2. Make sure the provider version in required_providers supports the V2 resources, then run terraform init -upgrade.\n")
-	b.WriteString("3. Run terraform plan -out=v2-migrate.tfplan and check it only imports and forgets resources, no destroy and no create.\n")
+	b.WriteString("3. Run terraform plan -out=v2-migrate.tfplan and check it only moves, imports and forgets resources, no destroy and no create.\n")
 	b.WriteString("4. Run terraform apply v2-migrate.tfplan.\n")
 	fmt.Fprintf(&b, "5. Delete %s and run terraform plan again, it must show no changes.\n", r.Migrations)
 	b.WriteString("6. If the plan wants to replace an instance interface, move is_default = true to the interface terraform reports as default.\n")
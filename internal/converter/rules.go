@@ -64,9 +64,12 @@ type Rules struct {
 	Resource TypePair `yaml:"resource"`
 	Data     TypePair `yaml:"data"`
 	ImportID string   `yaml:"import_id"`
-	Ops      []Op     `yaml:"ops"`
-	Refs     Refs     `yaml:"refs"`
-	DataRefs Refs     `yaml:"data_refs"`
+	// Moved reports that the provider accepts the state of the v1 resource in a moved block
+	// to the V2 one.
+	Moved    bool `yaml:"moved"`
+	Ops      []Op `yaml:"ops"`
+	Refs     Refs `yaml:"refs"`
+	DataRefs Refs `yaml:"data_refs"`
 
 	source string
 }
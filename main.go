@@ -1,9 +1,10 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
 	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter/provider"
@@ -19,12 +20,15 @@ func main() {
 	flag.StringVar(&address, "address", "provider", "this value is used in the TF_REATTACH_PROVIDERS environment variable during debugging")
 	flag.Parse()
 
+	serverFactory, err := provider.ProtoV5ProviderServer(context.Background(), version)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	opts := &plugin.ServeOpts{
-		Debug:        debug,
-		ProviderAddr: address,
-		ProviderFunc: func() *schema.Provider {
-			return provider.ProviderWithVersion(version)
-		},
+		Debug:            debug,
+		ProviderAddr:     address,
+		GRPCProviderFunc: serverFactory,
 	}
 
 	plugin.Serve(opts)